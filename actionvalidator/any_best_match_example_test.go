@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package actionvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+)
+
+func ExampleAnyBestMatch() {
+	// Used inside a action.Action type ConfigValidators method
+	_ = []action.ConfigValidator{
+		// The configuration must satisfy at least one validator,
+		// otherwise returning only the errors of the closest match.
+		actionvalidator.AnyBestMatch( /* ... */ ),
+	}
+}
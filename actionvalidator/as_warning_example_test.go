@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package actionvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+)
+
+func ExampleAsWarning() {
+	// Used inside a action.Action type ConfigValidators method
+	_ = []action.ConfigValidator{
+		// Return warnings, rather than errors, while practitioners
+		// update configurations before the validation is enforced.
+		actionvalidator.AsWarning(
+			actionvalidator.All( /* ... */ ),
+		),
+	}
+}
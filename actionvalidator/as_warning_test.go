@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package actionvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestAsWarningValidatorValidateAction(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator action.ConfigValidator
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorAction("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"multiple-errors": {
+			validator: actionvalidator.All(
+				testvalidator.ErrorAction("Error Summary", "first detail"),
+				testvalidator.ErrorAction("Error Summary", "second detail"),
+			),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"first detail",
+				),
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"second detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: actionvalidator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := action.ValidateConfigRequest{}
+			response := action.ValidateConfigResponse{}
+			actionvalidator.AsWarning(test.validator).ValidateAction(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAsWarningValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := actionvalidator.AsWarning(testvalidator.ErrorAction("Error Summary", "error detail"))
+
+	if got, expected := v.Description(context.Background()), "always returns an error diagnostic"; got != expected {
+		t.Errorf("expected description %q, got %q", expected, got)
+	}
+}
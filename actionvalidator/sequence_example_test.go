@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package actionvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+)
+
+func ExampleSequence() {
+	// Used inside a action.Action type ConfigValidators method
+	_ = []action.ConfigValidator{
+		// The configuration must satisfy each validator in order,
+		// stopping at the first validator which returns an error.
+		actionvalidator.Sequence( /* ... */ ),
+	}
+}
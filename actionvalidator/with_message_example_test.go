@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package actionvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+)
+
+func ExampleWithMessage() {
+	// Used inside a action.Action type ConfigValidators method
+	_ = []action.ConfigValidator{
+		// Replace the error diagnostic wording with domain-specific
+		// guidance for practitioners.
+		actionvalidator.WithMessage(
+			actionvalidator.All( /* ... */ ),
+			"Invalid Example Configuration",
+			"{{.Detail}} See the provider documentation for supported combinations.",
+		),
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package actionvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestWithMessageValidatorValidateAction(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		summary        string
+		detailTemplate string
+		expected       diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"summary-and-detail": {
+			summary:        "Custom Summary",
+			detailTemplate: "Custom detail: {{.Detail}}",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Custom Summary",
+					"Custom detail: error detail",
+				),
+			},
+		},
+		"unchanged": {
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := action.ValidateConfigRequest{}
+			response := action.ValidateConfigResponse{}
+			actionvalidator.WithMessage(
+				testvalidator.ErrorAction("Error Summary", "error detail"),
+				test.summary,
+				test.detailTemplate,
+			).ValidateAction(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWithMessageValidatorInvalidTemplate(t *testing.T) {
+	t.Parallel()
+
+	request := action.ValidateConfigRequest{}
+	response := action.ValidateConfigResponse{}
+	actionvalidator.WithMessage(
+		testvalidator.ErrorAction("Error Summary", "error detail"),
+		"",
+		"{{.Unknown}}",
+	).ValidateAction(context.Background(), request, &response)
+
+	if !response.Diagnostics.HasError() {
+		t.Fatal("expected error, got no error")
+	}
+
+	if got := response.Diagnostics[0].Summary(); got != "Invalid Validator Usage" {
+		t.Errorf("expected Invalid Validator Usage summary, got: %s", got)
+	}
+}
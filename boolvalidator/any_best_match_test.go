@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestAnyBestMatchValidatorValidateBool(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.Bool
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {},
+		"passing": {
+			validators: []validator.Bool{
+				testvalidator.ErrorBool("Error 1", "error 1 detail"),
+				testvalidator.WarningBool("Warning 1", "warning 1 detail"),
+				testvalidator.WarningBool("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+			},
+		},
+		"closest": {
+			validators: []validator.Bool{
+				boolvalidator.All(
+					testvalidator.ErrorBool("Error 1", "error 1 detail"),
+					testvalidator.ErrorBool("Error 2", "error 2 detail"),
+				),
+				testvalidator.ErrorBool("Error 3", "error 3 detail"),
+				testvalidator.ErrorBool("Error 4", "error 4 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must satisfy one of: Value must satisfy all of the validations: always returns an error diagnostic + always returns an error diagnostic, always returns an error diagnostic, always returns an error diagnostic\n\n"+
+						"The closest match, \"always returns an error diagnostic\", failed with:\n"+
+						"  - error 3 detail",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.BoolRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.BoolResponse{}
+
+			boolvalidator.AnyBestMatch(testCase.validators...).ValidateBool(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAnyBestMatchValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := boolvalidator.AnyBestMatch(
+		testvalidator.WarningBool("Warning 1", "warning 1 detail"),
+		testvalidator.ErrorBool("Error 1", "error 1 detail"),
+	)
+
+	expected := "Value must satisfy at least one of the validations: always returns a warning diagnostic + always returns an error diagnostic"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
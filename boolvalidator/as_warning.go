@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/diagutil"
+)
+
+// AsWarning returns a validator which converts any error diagnostics from the
+// given validator into warning diagnostics, preserving the path, summary, and
+// detail. Other diagnostics and the description are unchanged.
+//
+// This enables a staged rollout of a stricter validator, which first warns
+// practitioners of configurations it would reject before enforcing it.
+func AsWarning(v validator.Bool) validator.Bool {
+	return asWarningValidator{
+		validator: v,
+	}
+}
+
+var _ validator.Bool = asWarningValidator{}
+var _ validatorspec.ValidatorWithConstraint = asWarningValidator{}
+
+// asWarningValidator implements the validator.
+type asWarningValidator struct {
+	validator validator.Bool
+}
+
+// Description describes the validation in plain text formatting.
+func (v asWarningValidator) Description(ctx context.Context) string {
+	return v.validator.Description(ctx)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v asWarningValidator) MarkdownDescription(ctx context.Context) string {
+	return v.validator.MarkdownDescription(ctx)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v asWarningValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindAsWarning,
+		Children: []validatorspec.Constraint{validatorspec.Of(ctx, v.validator)},
+	}
+}
+
+// ValidateBool performs the validation.
+func (v asWarningValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	validateResp := &validator.BoolResponse{}
+
+	v.validator.ValidateBool(ctx, req, validateResp)
+
+	resp.Diagnostics.Append(diagutil.ErrorsToWarnings(validateResp.Diagnostics)...)
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestAsWarningValidatorValidateBool(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator validator.Bool
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorBool("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"multiple-errors": {
+			validator: boolvalidator.All(
+				testvalidator.ErrorBool("Error Summary", "first detail"),
+				testvalidator.ErrorBool("Error Summary", "second detail"),
+			),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"first detail",
+				),
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"second detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: boolvalidator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.BoolRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			response := validator.BoolResponse{}
+			boolvalidator.AsWarning(test.validator).ValidateBool(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAsWarningValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := boolvalidator.AsWarning(testvalidator.ErrorBool("Error Summary", "error detail"))
+
+	if got, expected := v.Description(context.Background()), "always returns an error diagnostic"; got != expected {
+		t.Errorf("expected description %q, got %q", expected, got)
+	}
+}
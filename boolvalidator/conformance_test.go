@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Bool{
+		"Equals":             boolvalidator.Equals(true),
+		"All":                boolvalidator.All(boolvalidator.Equals(true)),
+		"Any":                boolvalidator.Any(boolvalidator.Equals(true)),
+		"AnyWithAllWarnings": boolvalidator.AnyWithAllWarnings(boolvalidator.Equals(true)),
+		"AsWarning":          boolvalidator.AsWarning(boolvalidator.Equals(true)),
+		"AnyBestMatch":       boolvalidator.AnyBestMatch(boolvalidator.Equals(true)),
+		"Sequence":           boolvalidator.Sequence(boolvalidator.Equals(true)),
+		"Sensitive":          boolvalidator.Sensitive(boolvalidator.Equals(true)),
+		"WithMessage":        boolvalidator.WithMessage(boolvalidator.Equals(true), "Summary", "{{.Detail}}"),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
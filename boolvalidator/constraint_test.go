@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: boolvalidator.All(boolvalidator.Equals(true), boolvalidator.Equals(true)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindEquals,
+						Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+					},
+					{
+						Kind:   validatorspec.KindEquals,
+						Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: boolvalidator.Any(boolvalidator.Equals(true)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindEquals,
+						Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: boolvalidator.AnyBestMatch(boolvalidator.Equals(true)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindEquals,
+						Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: boolvalidator.AnyWithAllWarnings(boolvalidator.Equals(true)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindEquals,
+						Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: boolvalidator.AsWarning(boolvalidator.Equals(true)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindEquals,
+						Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+					},
+				},
+			},
+		},
+		"Sensitive": {
+			validator: boolvalidator.Sensitive(boolvalidator.Equals(true)),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindEquals,
+				Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+			},
+		},
+		"Sequence": {
+			validator: boolvalidator.Sequence(boolvalidator.Equals(true), boolvalidator.Equals(true)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindEquals,
+						Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+					},
+					{
+						Kind:   validatorspec.KindEquals,
+						Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: boolvalidator.WithMessage(boolvalidator.Equals(true), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindEquals,
+				Values: validatorspec.Values([]types.Bool{types.BoolValue(true)}),
+			},
+		},
+		"AlsoRequires": {
+			validator: boolvalidator.AlsoRequires(path.MatchRoot("a")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindAlsoRequires,
+				Paths: path.Expressions{path.MatchRoot("a")},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
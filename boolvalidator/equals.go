@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +18,7 @@ import (
 
 var _ validator.Bool = equalsValidator{}
 var _ function.BoolParameterValidator = equalsValidator{}
+var _ validatorspec.ValidatorWithConstraint = equalsValidator{}
 
 type equalsValidator struct {
 	value types.Bool
@@ -26,7 +29,14 @@ func (v equalsValidator) Description(ctx context.Context) string {
 }
 
 func (v equalsValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v equalsValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindEquals,
+		Values: validatorspec.Values([]types.Bool{v.value}),
+	}
 }
 
 func (v equalsValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
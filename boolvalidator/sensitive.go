@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/diagutil"
+)
+
+// Sensitive returns a validator which replaces the configured value in any
+// diagnostics from the given validator with validatordiag.RedactedValue, so
+// secrets are not shown in Terraform output or logs. Any text following
+// ", got: " in the diagnostic summary or detail, where validators in this
+// module report the configured value, is replaced.
+//
+// The value, as formatted by its String method, is also replaced wherever it
+// appears in the diagnostic summary or detail. Values shorter than 4 characters
+// are only replaced where they are not part of a longer word or number.
+//
+// Validators intended for secret values, such as write-only or ephemeral
+// attributes, should use this wrapper unless the validator never reports the
+// configured value. The description is unchanged.
+//
+// PreferWriteOnlyAttribute, the validator of this package intended for secret
+// values, never reports the configured value, so it does not redact by
+// default and does not need this wrapper.
+//
+// When used with function parameters, the given validator must also implement
+// function.BoolParameterValidator.
+func Sensitive(v validator.Bool) sensitiveValidator {
+	return sensitiveValidator{
+		validator: v,
+	}
+}
+
+var _ validator.Bool = sensitiveValidator{}
+var _ function.BoolParameterValidator = sensitiveValidator{}
+var _ validatorspec.ValidatorWithConstraint = sensitiveValidator{}
+
+// sensitiveValidator implements the validator.
+type sensitiveValidator struct {
+	validator validator.Bool
+}
+
+// Description describes the validation in plain text formatting.
+func (v sensitiveValidator) Description(ctx context.Context) string {
+	return v.validator.Description(ctx)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sensitiveValidator) MarkdownDescription(ctx context.Context) string {
+	return v.validator.MarkdownDescription(ctx)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v sensitiveValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Of(ctx, v.validator)
+}
+
+// ValidateBool performs the validation.
+func (v sensitiveValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	validateResp := &validator.BoolResponse{}
+
+	v.validator.ValidateBool(ctx, req, validateResp)
+
+	resp.Diagnostics.Append(diagutil.RedactDiagnostics(validateResp.Diagnostics, req.ConfigValue.String())...)
+}
+
+// ValidateParameterBool performs the validation.
+func (v sensitiveValidator) ValidateParameterBool(ctx context.Context, req function.BoolParameterValidatorRequest, resp *function.BoolParameterValidatorResponse) {
+	parameterValidator, ok := v.validator.(function.BoolParameterValidator)
+
+	if !ok {
+		resp.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			req.ArgumentPosition,
+			"Sensitive",
+			"the wrapped validator does not implement function.BoolParameterValidator",
+		)
+
+		return
+	}
+
+	validateResp := &function.BoolParameterValidatorResponse{}
+
+	parameterValidator.ValidateParameterBool(ctx, req, validateResp)
+
+	resp.Error = diagutil.RedactFuncError(validateResp.Error, req.Value.String())
+}
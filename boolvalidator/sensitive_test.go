@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestSensitiveValidatorValidateBool(t *testing.T) {
+	t.Parallel()
+
+	value := types.BoolValue(true)
+
+	type testCase struct {
+		validator validator.Bool
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorBool("Error Summary", "value must be valid, got: "+value.String()),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"value must be valid, got: (sensitive value)",
+				),
+			},
+		},
+		"no-value": {
+			validator: testvalidator.ErrorBool("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: boolvalidator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.BoolRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    value,
+			}
+			response := validator.BoolResponse{}
+			boolvalidator.Sensitive(test.validator).ValidateBool(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSensitiveValidatorValidateParameterBool(t *testing.T) {
+	t.Parallel()
+
+	value := types.BoolValue(true)
+
+	request := function.BoolParameterValidatorRequest{
+		ArgumentPosition: 0,
+		Value:            value,
+	}
+	response := function.BoolParameterValidatorResponse{}
+	boolvalidator.Sensitive(
+		testvalidator.ErrorBool("Error Summary", "value must be valid, got: "+value.String()),
+	).ValidateParameterBool(context.Background(), request, &response)
+
+	expected := function.NewArgumentFuncError(0, "Error Summary: value must be valid, got: (sensitive value)")
+
+	if diff := cmp.Diff(response.Error, expected); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+)
+
+func ExampleSequence() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.BoolAttribute{
+				Required: true,
+				Validators: []validator.Bool{
+					// Validate this value against each validator in order,
+					// stopping at the first validator which returns an error.
+					boolvalidator.Sequence( /* ... */ ),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/diagutil"
+)
+
+// WithMessage returns a validator which replaces the summary and detail of
+// any error diagnostics from the given validator. An empty summary keeps the
+// original summary. The detailTemplate is a text/template, where an empty
+// template keeps the original detail, which can reference:
+//
+//   - {{.Path}}: the path of the diagnostic, if any
+//   - {{.Value}}: the validated value, such as "example" for strings
+//   - {{.Summary}}: the original summary
+//   - {{.Detail}}: the original detail
+//
+// Warning diagnostics and the description are unchanged. An invalid
+// detailTemplate will result in an implementation error message during
+// validation.
+//
+// When used with function parameters, the given validator must also implement
+// function.BoolParameterValidator, the function error text becomes the summary
+// followed by the rendered detail, and {{.Path}} and {{.Summary}} are empty.
+func WithMessage(v validator.Bool, summary string, detailTemplate string) withMessageValidator {
+	return withMessageValidator{
+		validator: v,
+		message:   diagutil.NewMessage(summary, detailTemplate),
+	}
+}
+
+var _ validator.Bool = withMessageValidator{}
+var _ function.BoolParameterValidator = withMessageValidator{}
+var _ validatorspec.ValidatorWithConstraint = withMessageValidator{}
+
+// withMessageValidator implements the validator.
+type withMessageValidator struct {
+	validator validator.Bool
+	message   diagutil.Message
+}
+
+// Description describes the validation in plain text formatting.
+func (v withMessageValidator) Description(ctx context.Context) string {
+	return v.validator.Description(ctx)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v withMessageValidator) MarkdownDescription(ctx context.Context) string {
+	return v.validator.MarkdownDescription(ctx)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v withMessageValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Of(ctx, v.validator)
+}
+
+// ValidateBool performs the validation.
+func (v withMessageValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if err := v.message.Err(); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidValidatorUsageDiagnostic(req.Path, "WithMessage", err.Error()))
+
+		return
+	}
+
+	validateResp := &validator.BoolResponse{}
+
+	v.validator.ValidateBool(ctx, req, validateResp)
+
+	resp.Diagnostics.Append(v.message.Diagnostics(validateResp.Diagnostics, req.ConfigValue.String())...)
+}
+
+// ValidateParameterBool performs the validation.
+func (v withMessageValidator) ValidateParameterBool(ctx context.Context, req function.BoolParameterValidatorRequest, resp *function.BoolParameterValidatorResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if err := v.message.Err(); err != nil {
+		resp.Error = validatorfuncerr.InvalidValidatorUsageFuncError(req.ArgumentPosition, "WithMessage", err.Error())
+
+		return
+	}
+
+	parameterValidator, ok := v.validator.(function.BoolParameterValidator)
+
+	if !ok {
+		resp.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			req.ArgumentPosition,
+			"WithMessage",
+			"the wrapped validator does not implement function.BoolParameterValidator",
+		)
+
+		return
+	}
+
+	validateResp := &function.BoolParameterValidatorResponse{}
+
+	parameterValidator.ValidateParameterBool(ctx, req, validateResp)
+
+	resp.Error = v.message.FuncError(validateResp.Error, req.Value.String())
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestWithMessageValidatorValidateBool(t *testing.T) {
+	t.Parallel()
+
+	value := types.BoolValue(true)
+
+	type testCase struct {
+		summary        string
+		detailTemplate string
+		expected       diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"summary-and-detail": {
+			summary:        "Custom Summary",
+			detailTemplate: "Custom detail for {{.Path}}, got: {{.Value}}",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Custom Summary",
+					"Custom detail for test, got: "+value.String(),
+				),
+			},
+		},
+		"original-detail": {
+			detailTemplate: "{{.Summary}}: {{.Detail}}",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"Error Summary: error detail",
+				),
+			},
+		},
+		"unchanged": {
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.BoolRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    value,
+			}
+			response := validator.BoolResponse{}
+			boolvalidator.WithMessage(
+				testvalidator.ErrorBool("Error Summary", "error detail"),
+				test.summary,
+				test.detailTemplate,
+			).ValidateBool(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWithMessageValidatorValidateParameterBool(t *testing.T) {
+	t.Parallel()
+
+	value := types.BoolValue(true)
+
+	request := function.BoolParameterValidatorRequest{
+		ArgumentPosition: 0,
+		Value:            value,
+	}
+	response := function.BoolParameterValidatorResponse{}
+	boolvalidator.WithMessage(
+		testvalidator.ErrorBool("Error Summary", "error detail"),
+		"Custom Summary",
+		"got: {{.Value}}",
+	).ValidateParameterBool(context.Background(), request, &response)
+
+	expected := function.NewArgumentFuncError(0, "Custom Summary: got: "+value.String())
+
+	if diff := cmp.Diff(response.Error, expected); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+}
+
+func TestWithMessageValidatorInvalidTemplate(t *testing.T) {
+	t.Parallel()
+
+	request := validator.BoolRequest{
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+		ConfigValue:    types.BoolValue(true),
+	}
+	response := validator.BoolResponse{}
+	boolvalidator.WithMessage(
+		testvalidator.ErrorBool("Error Summary", "error detail"),
+		"",
+		"{{.Unknown}}",
+	).ValidateBool(context.Background(), request, &response)
+
+	if !response.Diagnostics.HasError() {
+		t.Fatal("expected error, got no error")
+	}
+
+	if got := response.Diagnostics[0].Summary(); got != "Invalid Validator Usage" {
+		t.Errorf("expected Invalid Validator Usage summary, got: %s", got)
+	}
+}
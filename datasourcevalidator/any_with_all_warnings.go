@@ -9,6 +9,9 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // AnyWithAllWarnings returns a validator which ensures that any configured
@@ -23,6 +26,7 @@ func AnyWithAllWarnings(validators ...datasource.ConfigValidator) datasource.Con
 }
 
 var _ datasource.ConfigValidator = anyWithAllWarningsValidator{}
+var _ validatorspec.ValidatorWithConstraint = anyWithAllWarningsValidator{}
 
 // anyWithAllWarningsValidator implements the validator.
 type anyWithAllWarningsValidator struct {
@@ -42,7 +46,15 @@ func (v anyWithAllWarningsValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v anyWithAllWarningsValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v anyWithAllWarningsValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindAnyWithAllWarnings,
+		Children: validatorspec.OfAll(ctx, v.validators),
+	}
 }
 
 // ValidateDataSource performs the validation.
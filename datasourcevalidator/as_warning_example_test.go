@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+)
+
+func ExampleAsWarning() {
+	// Used inside a datasource.DataSource type ConfigValidators method
+	_ = []datasource.ConfigValidator{
+		// Return warnings, rather than errors, while practitioners
+		// update configurations before the validation is enforced.
+		datasourcevalidator.AsWarning(
+			datasourcevalidator.All( /* ... */ ),
+		),
+	}
+}
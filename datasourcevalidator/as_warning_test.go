@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasourcevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestAsWarningValidatorValidateDataSource(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator datasource.ConfigValidator
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorDataSource("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"multiple-errors": {
+			validator: datasourcevalidator.All(
+				testvalidator.ErrorDataSource("Error Summary", "first detail"),
+				testvalidator.ErrorDataSource("Error Summary", "second detail"),
+			),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"first detail",
+				),
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"second detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: datasourcevalidator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := datasource.ValidateConfigRequest{}
+			response := datasource.ValidateConfigResponse{}
+			datasourcevalidator.AsWarning(test.validator).ValidateDataSource(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAsWarningValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := datasourcevalidator.AsWarning(testvalidator.ErrorDataSource("Error Summary", "error detail"))
+
+	if got, expected := v.Description(context.Background()), "always returns an error diagnostic"; got != expected {
+		t.Errorf("expected description %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasourcevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: datasourcevalidator.All(datasourcevalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: datasourcevalidator.Any(datasourcevalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: datasourcevalidator.AnyBestMatch(datasourcevalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: datasourcevalidator.AnyWithAllWarnings(datasourcevalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: datasourcevalidator.AsWarning(datasourcevalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"Sequence": {
+			validator: datasourcevalidator.Sequence(datasourcevalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: datasourcevalidator.WithMessage(datasourcevalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b")), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindConflicting,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+		"AtLeastOneOf": {
+			validator: datasourcevalidator.AtLeastOneOf(path.MatchRoot("a"), path.MatchRoot("b")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindAtLeastOneOf,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+		"Conflicting": {
+			validator: datasourcevalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindConflicting,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+		"ExactlyOneOf": {
+			validator: datasourcevalidator.ExactlyOneOf(path.MatchRoot("a"), path.MatchRoot("b")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindExactlyOneOf,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+		"RequiredTogether": {
+			validator: datasourcevalidator.RequiredTogether(path.MatchRoot("a"), path.MatchRoot("b")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindRequiredTogether,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+)
+
+func ExampleSequence() {
+	// Used inside a datasource.DataSource type ConfigValidators method
+	_ = []datasource.ConfigValidator{
+		// The configuration must satisfy each validator in order,
+		// stopping at the first validator which returns an error.
+		datasourcevalidator.Sequence( /* ... */ ),
+	}
+}
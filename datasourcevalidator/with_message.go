@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasourcevalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/diagutil"
+)
+
+// WithMessage returns a validator which replaces the summary and detail of
+// any error diagnostics from the given validator. An empty summary keeps the
+// original summary. The detailTemplate is a text/template, where an empty
+// template keeps the original detail, which can reference:
+//
+//   - {{.Path}}: the path of the diagnostic, if any
+//   - {{.Value}}: always empty for configuration validators
+//   - {{.Summary}}: the original summary
+//   - {{.Detail}}: the original detail
+//
+// Warning diagnostics and the description are unchanged. An invalid
+// detailTemplate will result in an implementation error message during
+// validation.
+func WithMessage(v datasource.ConfigValidator, summary string, detailTemplate string) datasource.ConfigValidator {
+	return withMessageValidator{
+		validator: v,
+		message:   diagutil.NewMessage(summary, detailTemplate),
+	}
+}
+
+var _ datasource.ConfigValidator = withMessageValidator{}
+var _ validatorspec.ValidatorWithConstraint = withMessageValidator{}
+
+// withMessageValidator implements the validator.
+type withMessageValidator struct {
+	validator datasource.ConfigValidator
+	message   diagutil.Message
+}
+
+// Description describes the validation in plain text formatting.
+func (v withMessageValidator) Description(ctx context.Context) string {
+	return v.validator.Description(ctx)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v withMessageValidator) MarkdownDescription(ctx context.Context) string {
+	return v.validator.MarkdownDescription(ctx)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v withMessageValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Of(ctx, v.validator)
+}
+
+// ValidateDataSource performs the validation.
+func (v withMessageValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if err := v.message.Err(); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidValidatorUsageDiagnostic(path.Empty(), "WithMessage", err.Error()))
+
+		return
+	}
+
+	validateResp := &datasource.ValidateConfigResponse{}
+
+	v.validator.ValidateDataSource(ctx, req, validateResp)
+
+	resp.Diagnostics.Append(v.message.Diagnostics(validateResp.Diagnostics, "")...)
+}
@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+)
+
+func ExampleWithMessage() {
+	// Used inside a datasource.DataSource type ConfigValidators method
+	_ = []datasource.ConfigValidator{
+		// Replace the error diagnostic wording with domain-specific
+		// guidance for practitioners.
+		datasourcevalidator.WithMessage(
+			datasourcevalidator.All( /* ... */ ),
+			"Invalid Example Configuration",
+			"{{.Detail}} See the provider documentation for supported combinations.",
+		),
+	}
+}
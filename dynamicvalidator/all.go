@@ -9,6 +9,9 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // All returns a validator which ensures that any configured attribute value
@@ -23,6 +26,7 @@ func All(validators ...validator.Dynamic) validator.Dynamic {
 }
 
 var _ validator.Dynamic = allValidator{}
+var _ validatorspec.ValidatorWithConstraint = allValidator{}
 
 // allValidator implements the validator.
 type allValidator struct {
@@ -42,7 +46,15 @@ func (v allValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v allValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v allValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindAll,
+		Children: validatorspec.OfAll(ctx, v.validators),
+	}
 }
 
 // ValidateDynamic performs the validation.
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// AnyBestMatch returns a validator which ensures that any configured attribute value
+// passes at least one of the given validators.
+//
+// Unlike Any, which returns the errors of every validator, a single error
+// diagnostic is returned when no validator passes. It lists the descriptions
+// of the given validators, followed by the errors of the closest match: the
+// validator which returned the fewest errors, or the earliest of those which
+// returned the same number of errors. Only warnings from the passing validator
+// are returned.
+func AnyBestMatch(validators ...validator.Dynamic) validator.Dynamic {
+	return anyBestMatchValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.Dynamic = anyBestMatchValidator{}
+var _ validatorspec.ValidatorWithConstraint = anyBestMatchValidator{}
+
+// anyBestMatchValidator implements the validator.
+type anyBestMatchValidator struct {
+	validators []validator.Dynamic
+}
+
+// Description describes the validation in plain text formatting.
+func (v anyBestMatchValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Value must satisfy at least one of the validations: %s", strings.Join(v.descriptions(ctx), " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v anyBestMatchValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v anyBestMatchValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindAny,
+		Children: validatorspec.OfAll(ctx, v.validators),
+	}
+}
+
+// ValidateDynamic performs the validation.
+func (v anyBestMatchValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	var closest *validator.DynamicResponse
+	var closestIndex int
+
+	for index, subValidator := range v.validators {
+		validateResp := &validator.DynamicResponse{}
+
+		subValidator.ValidateDynamic(ctx, req, validateResp)
+
+		if !validateResp.Diagnostics.HasError() {
+			resp.Diagnostics = validateResp.Diagnostics
+
+			return
+		}
+
+		if closest == nil || validateResp.Diagnostics.ErrorsCount() < closest.Diagnostics.ErrorsCount() {
+			closest = validateResp
+			closestIndex = index
+		}
+	}
+
+	if closest == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.NoMatchingValidatorDiagnostic(
+		req.Path,
+		v.descriptions(ctx),
+		v.validators[closestIndex].Description(ctx),
+		closest.Diagnostics,
+	))
+}
+
+// descriptions returns the descriptions of the validators.
+func (v anyBestMatchValidator) descriptions(ctx context.Context) []string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return descriptions
+}
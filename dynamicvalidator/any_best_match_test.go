@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/dynamicvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestAnyBestMatchValidatorValidateDynamic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.Dynamic
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {},
+		"passing": {
+			validators: []validator.Dynamic{
+				testvalidator.ErrorDynamic("Error 1", "error 1 detail"),
+				testvalidator.WarningDynamic("Warning 1", "warning 1 detail"),
+				testvalidator.WarningDynamic("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+			},
+		},
+		"closest": {
+			validators: []validator.Dynamic{
+				dynamicvalidator.All(
+					testvalidator.ErrorDynamic("Error 1", "error 1 detail"),
+					testvalidator.ErrorDynamic("Error 2", "error 2 detail"),
+				),
+				testvalidator.ErrorDynamic("Error 3", "error 3 detail"),
+				testvalidator.ErrorDynamic("Error 4", "error 4 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must satisfy one of: Value must satisfy all of the validations: always returns an error diagnostic + always returns an error diagnostic, always returns an error diagnostic, always returns an error diagnostic\n\n"+
+						"The closest match, \"always returns an error diagnostic\", failed with:\n"+
+						"  - error 3 detail",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.DynamicRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.DynamicResponse{}
+
+			dynamicvalidator.AnyBestMatch(testCase.validators...).ValidateDynamic(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAnyBestMatchValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := dynamicvalidator.AnyBestMatch(
+		testvalidator.WarningDynamic("Warning 1", "warning 1 detail"),
+		testvalidator.ErrorDynamic("Error 1", "error 1 detail"),
+	)
+
+	expected := "Value must satisfy at least one of the validations: always returns a warning diagnostic + always returns an error diagnostic"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
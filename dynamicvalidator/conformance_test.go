@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/dynamicvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Dynamic{
+		"All":          dynamicvalidator.All(),
+		"AsWarning":    dynamicvalidator.AsWarning(dynamicvalidator.All()),
+		"AnyBestMatch": dynamicvalidator.AnyBestMatch(dynamicvalidator.All()),
+		"Sequence":     dynamicvalidator.Sequence(),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/dynamicvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: dynamicvalidator.All(dynamicvalidator.ConflictsWith(path.MatchRoot("other")), dynamicvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: dynamicvalidator.Any(dynamicvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: dynamicvalidator.AnyBestMatch(dynamicvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: dynamicvalidator.AnyWithAllWarnings(dynamicvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: dynamicvalidator.AsWarning(dynamicvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"Sensitive": {
+			validator: dynamicvalidator.Sensitive(dynamicvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindConflictsWith,
+				Paths: path.Expressions{path.MatchRoot("other")},
+			},
+		},
+		"Sequence": {
+			validator: dynamicvalidator.Sequence(dynamicvalidator.ConflictsWith(path.MatchRoot("other")), dynamicvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: dynamicvalidator.WithMessage(dynamicvalidator.ConflictsWith(path.MatchRoot("other")), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindConflictsWith,
+				Paths: path.Expressions{path.MatchRoot("other")},
+			},
+		},
+		"ExactlyOneOf": {
+			validator: dynamicvalidator.ExactlyOneOf(path.MatchRoot("a")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindExactlyOneOf,
+				Paths: path.Expressions{path.MatchRoot("a")},
+			},
+		},
+		"PreferWriteOnlyAttribute": {
+			validator: dynamicvalidator.PreferWriteOnlyAttribute(path.MatchRoot("a_wo")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindPreferWriteOnlyAttribute,
+				Paths: path.Expressions{path.MatchRoot("a_wo")},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/diagutil"
+)
+
+// Sensitive returns a validator which replaces the configured value in any
+// diagnostics from the given validator with validatordiag.RedactedValue, so
+// secrets are not shown in Terraform output or logs. Any text following
+// ", got: " in the diagnostic summary or detail, where validators in this
+// module report the configured value, is replaced.
+//
+// The value, as formatted by its String method, is also replaced wherever it
+// appears in the diagnostic summary or detail. Values shorter than 4 characters
+// are only replaced where they are not part of a longer word or number.
+//
+// Validators intended for secret values, such as write-only or ephemeral
+// attributes, should use this wrapper unless the validator never reports the
+// configured value. The description is unchanged.
+//
+// PreferWriteOnlyAttribute, the validator of this package intended for secret
+// values, never reports the configured value, so it does not redact by
+// default and does not need this wrapper.
+//
+// When used with function parameters, the given validator must also implement
+// function.DynamicParameterValidator.
+func Sensitive(v validator.Dynamic) sensitiveValidator {
+	return sensitiveValidator{
+		validator: v,
+	}
+}
+
+var _ validator.Dynamic = sensitiveValidator{}
+var _ function.DynamicParameterValidator = sensitiveValidator{}
+var _ validatorspec.ValidatorWithConstraint = sensitiveValidator{}
+
+// sensitiveValidator implements the validator.
+type sensitiveValidator struct {
+	validator validator.Dynamic
+}
+
+// Description describes the validation in plain text formatting.
+func (v sensitiveValidator) Description(ctx context.Context) string {
+	return v.validator.Description(ctx)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sensitiveValidator) MarkdownDescription(ctx context.Context) string {
+	return v.validator.MarkdownDescription(ctx)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v sensitiveValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Of(ctx, v.validator)
+}
+
+// ValidateDynamic performs the validation.
+func (v sensitiveValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	validateResp := &validator.DynamicResponse{}
+
+	v.validator.ValidateDynamic(ctx, req, validateResp)
+
+	resp.Diagnostics.Append(diagutil.RedactDiagnostics(validateResp.Diagnostics, req.ConfigValue.String())...)
+}
+
+// ValidateParameterDynamic performs the validation.
+func (v sensitiveValidator) ValidateParameterDynamic(ctx context.Context, req function.DynamicParameterValidatorRequest, resp *function.DynamicParameterValidatorResponse) {
+	parameterValidator, ok := v.validator.(function.DynamicParameterValidator)
+
+	if !ok {
+		resp.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			req.ArgumentPosition,
+			"Sensitive",
+			"the wrapped validator does not implement function.DynamicParameterValidator",
+		)
+
+		return
+	}
+
+	validateResp := &function.DynamicParameterValidatorResponse{}
+
+	parameterValidator.ValidateParameterDynamic(ctx, req, validateResp)
+
+	resp.Error = diagutil.RedactFuncError(validateResp.Error, req.Value.String())
+}
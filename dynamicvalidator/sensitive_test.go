@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/dynamicvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestSensitiveValidatorValidateDynamic(t *testing.T) {
+	t.Parallel()
+
+	value := types.DynamicValue(types.StringValue("ab"))
+
+	type testCase struct {
+		validator validator.Dynamic
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorDynamic("Error Summary", "value must be valid, got: "+value.String()),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"value must be valid, got: (sensitive value)",
+				),
+			},
+		},
+		"no-value": {
+			validator: testvalidator.ErrorDynamic("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: dynamicvalidator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.DynamicRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    value,
+			}
+			response := validator.DynamicResponse{}
+			dynamicvalidator.Sensitive(test.validator).ValidateDynamic(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSensitiveValidatorValidateParameterDynamic(t *testing.T) {
+	t.Parallel()
+
+	value := types.DynamicValue(types.StringValue("ab"))
+
+	request := function.DynamicParameterValidatorRequest{
+		ArgumentPosition: 0,
+		Value:            value,
+	}
+	response := function.DynamicParameterValidatorResponse{}
+	dynamicvalidator.Sensitive(
+		testvalidator.ErrorDynamic("Error Summary", "value must be valid, got: "+value.String()),
+	).ValidateParameterDynamic(context.Background(), request, &response)
+
+	expected := function.NewArgumentFuncError(0, "Error Summary: value must be valid, got: (sensitive value)")
+
+	if diff := cmp.Diff(response.Error, expected); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// Sequence returns a validator which ensures that any configured attribute
+// value validates against all the given validators, in order. Validation
+// stops at the first validator which returns an error diagnostic, so later
+// validators which depend on the success of earlier validators do not
+// return additional errors. Warning diagnostics are kept.
+//
+// When used with function parameters, each of the given validators must also
+// implement function.DynamicParameterValidator.
+func Sequence(validators ...validator.Dynamic) sequenceValidator {
+	return sequenceValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.Dynamic = sequenceValidator{}
+var _ function.DynamicParameterValidator = sequenceValidator{}
+var _ validatorspec.ValidatorWithConstraint = sequenceValidator{}
+
+// sequenceValidator implements the validator.
+type sequenceValidator struct {
+	validators []validator.Dynamic
+}
+
+// Description describes the validation in plain text formatting.
+func (v sequenceValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("Value must satisfy each of the validations in order: %s", strings.Join(descriptions, " then "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sequenceValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v sequenceValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindSequence,
+		Children: validatorspec.OfAll(ctx, v.validators),
+	}
+}
+
+// ValidateDynamic performs the validation.
+func (v sequenceValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	for _, subValidator := range v.validators {
+		validateResp := &validator.DynamicResponse{}
+
+		subValidator.ValidateDynamic(ctx, req, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// ValidateParameterDynamic performs the validation.
+func (v sequenceValidator) ValidateParameterDynamic(ctx context.Context, req function.DynamicParameterValidatorRequest, resp *function.DynamicParameterValidatorResponse) {
+	for index, subValidator := range v.validators {
+		parameterValidator, ok := subValidator.(function.DynamicParameterValidator)
+
+		if !ok {
+			resp.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+				req.ArgumentPosition,
+				"Sequence",
+				fmt.Sprintf("the validator at index %d does not implement function.DynamicParameterValidator", index),
+			)
+
+			return
+		}
+
+		validateResp := &function.DynamicParameterValidatorResponse{}
+
+		parameterValidator.ValidateParameterDynamic(ctx, req, validateResp)
+
+		if validateResp.Error != nil {
+			resp.Error = validateResp.Error
+
+			return
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/dynamicvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestSequenceValidatorValidateDynamic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.Dynamic
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {},
+		"warnings": {
+			validators: []validator.Dynamic{
+				testvalidator.WarningDynamic("Warning 1", "warning 1 detail"),
+				testvalidator.WarningDynamic("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewWarningDiagnostic("Warning 2", "warning 2 detail"),
+			},
+		},
+		"error-stops": {
+			validators: []validator.Dynamic{
+				testvalidator.WarningDynamic("Warning 1", "warning 1 detail"),
+				testvalidator.ErrorDynamic("Error 1", "error 1 detail"),
+				testvalidator.ErrorDynamic("Error 2", "error 2 detail"),
+				testvalidator.WarningDynamic("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error 1", "error 1 detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.DynamicRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.DynamicResponse{}
+
+			dynamicvalidator.Sequence(testCase.validators...).ValidateDynamic(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSequenceValidatorValidateParameterDynamic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.Dynamic
+		expected   *function.FuncError
+	}{
+		"no-validators": {},
+		"error-stops": {
+			validators: []validator.Dynamic{
+				testvalidator.ErrorDynamic("Error 1", "error 1 detail"),
+				testvalidator.ErrorDynamic("Error 2", "error 2 detail"),
+			},
+			expected: function.NewArgumentFuncError(0, "Error 1: error 1 detail"),
+		},
+		"error-before-unsupported": {
+			validators: []validator.Dynamic{
+				testvalidator.ErrorDynamic("Error 1", "error 1 detail"),
+				testvalidator.WarningDynamic("Warning 1", "warning 1 detail"),
+			},
+			expected: function.NewArgumentFuncError(0, "Error 1: error 1 detail"),
+		},
+		"unsupported": {
+			validators: []validator.Dynamic{
+				testvalidator.WarningDynamic("Warning 1", "warning 1 detail"),
+				testvalidator.ErrorDynamic("Error 1", "error 1 detail"),
+			},
+			expected: validatorfuncerr.InvalidValidatorUsageFuncError(
+				0,
+				"Sequence",
+				"the validator at index 0 does not implement function.DynamicParameterValidator",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := function.DynamicParameterValidatorRequest{
+				ArgumentPosition: 0,
+			}
+			resp := &function.DynamicParameterValidatorResponse{}
+
+			dynamicvalidator.Sequence(testCase.validators...).ValidateParameterDynamic(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Error); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSequenceValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := dynamicvalidator.Sequence(
+		testvalidator.WarningDynamic("Warning 1", "warning 1 detail"),
+		testvalidator.ErrorDynamic("Error 1", "error 1 detail"),
+	)
+
+	expected := "Value must satisfy each of the validations in order: always returns a warning diagnostic then always returns an error diagnostic"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
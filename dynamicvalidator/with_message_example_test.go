@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/dynamicvalidator"
+)
+
+func ExampleWithMessage() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.DynamicAttribute{
+				Required: true,
+				Validators: []validator.Dynamic{
+					// Replace the error diagnostic wording with domain-specific
+					// guidance for practitioners.
+					dynamicvalidator.WithMessage(
+						dynamicvalidator.All( /* ... */ ),
+						"Invalid Example Value",
+						"The example value at {{.Path}} must follow the naming rules, got: {{.Value}}",
+					),
+				},
+			},
+		},
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // Any returns a validator which ensures that any configured attribute value
@@ -25,6 +28,7 @@ func Any(validators ...ephemeral.ConfigValidator) ephemeral.ConfigValidator {
 }
 
 var _ ephemeral.ConfigValidator = anyValidator{}
+var _ validatorspec.ValidatorWithConstraint = anyValidator{}
 
 // anyValidator implements the validator.
 type anyValidator struct {
@@ -44,7 +48,15 @@ func (v anyValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v anyValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v anyValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindAny,
+		Children: validatorspec.OfAll(ctx, v.validators),
+	}
 }
 
 // ValidateEphemeralResource performs the validation.
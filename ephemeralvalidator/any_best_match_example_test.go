@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+)
+
+func ExampleAnyBestMatch() {
+	// Used inside a ephemeral.EphemeralResource type ConfigValidators method
+	_ = []ephemeral.ConfigValidator{
+		// The configuration must satisfy at least one validator,
+		// otherwise returning only the errors of the closest match.
+		ephemeralvalidator.AnyBestMatch( /* ... */ ),
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestAnyBestMatchValidatorValidateEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []ephemeral.ConfigValidator
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {},
+		"passing": {
+			validators: []ephemeral.ConfigValidator{
+				testvalidator.ErrorEphemeralResource("Error 1", "error 1 detail"),
+				testvalidator.WarningEphemeralResource("Warning 1", "warning 1 detail"),
+				testvalidator.WarningEphemeralResource("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+			},
+		},
+		"closest": {
+			validators: []ephemeral.ConfigValidator{
+				ephemeralvalidator.All(
+					testvalidator.ErrorEphemeralResource("Error 1", "error 1 detail"),
+					testvalidator.ErrorEphemeralResource("Error 2", "error 2 detail"),
+				),
+				testvalidator.ErrorEphemeralResource("Error 3", "error 3 detail"),
+				testvalidator.ErrorEphemeralResource("Error 4", "error 4 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Configuration",
+					"Configuration must satisfy one of: Value must satisfy all of the validations: always returns an error diagnostic + always returns an error diagnostic, always returns an error diagnostic, always returns an error diagnostic\n\n"+
+						"The closest match, \"always returns an error diagnostic\", failed with:\n"+
+						"  - error 3 detail",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := ephemeral.ValidateConfigRequest{}
+			resp := &ephemeral.ValidateConfigResponse{}
+
+			ephemeralvalidator.AnyBestMatch(testCase.validators...).ValidateEphemeralResource(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAnyBestMatchValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := ephemeralvalidator.AnyBestMatch(
+		testvalidator.WarningEphemeralResource("Warning 1", "warning 1 detail"),
+		testvalidator.ErrorEphemeralResource("Error 1", "error 1 detail"),
+	)
+
+	expected := "Value must satisfy at least one of the validations: always returns a warning diagnostic + always returns an error diagnostic"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+)
+
+func ExampleAsWarning() {
+	// Used inside a ephemeral.EphemeralResource type ConfigValidators method
+	_ = []ephemeral.ConfigValidator{
+		// Return warnings, rather than errors, while practitioners
+		// update configurations before the validation is enforced.
+		ephemeralvalidator.AsWarning(
+			ephemeralvalidator.All( /* ... */ ),
+		),
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestAsWarningValidatorValidateEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator ephemeral.ConfigValidator
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorEphemeralResource("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"multiple-errors": {
+			validator: ephemeralvalidator.All(
+				testvalidator.ErrorEphemeralResource("Error Summary", "first detail"),
+				testvalidator.ErrorEphemeralResource("Error Summary", "second detail"),
+			),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"first detail",
+				),
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"second detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: ephemeralvalidator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := ephemeral.ValidateConfigRequest{}
+			response := ephemeral.ValidateConfigResponse{}
+			ephemeralvalidator.AsWarning(test.validator).ValidateEphemeralResource(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAsWarningValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := ephemeralvalidator.AsWarning(testvalidator.ErrorEphemeralResource("Error Summary", "error detail"))
+
+	if got, expected := v.Description(context.Background()), "always returns an error diagnostic"; got != expected {
+		t.Errorf("expected description %q, got %q", expected, got)
+	}
+}
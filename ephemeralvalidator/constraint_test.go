@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: ephemeralvalidator.All(ephemeralvalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: ephemeralvalidator.Any(ephemeralvalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: ephemeralvalidator.AnyBestMatch(ephemeralvalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: ephemeralvalidator.AnyWithAllWarnings(ephemeralvalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: ephemeralvalidator.AsWarning(ephemeralvalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"Sequence": {
+			validator: ephemeralvalidator.Sequence(ephemeralvalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflicting,
+						Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: ephemeralvalidator.WithMessage(ephemeralvalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b")), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindConflicting,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+		"AtLeastOneOf": {
+			validator: ephemeralvalidator.AtLeastOneOf(path.MatchRoot("a"), path.MatchRoot("b")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindAtLeastOneOf,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+		"Conflicting": {
+			validator: ephemeralvalidator.Conflicting(path.MatchRoot("a"), path.MatchRoot("b")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindConflicting,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+		"ExactlyOneOf": {
+			validator: ephemeralvalidator.ExactlyOneOf(path.MatchRoot("a"), path.MatchRoot("b")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindExactlyOneOf,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+		"RequiredTogether": {
+			validator: ephemeralvalidator.RequiredTogether(path.MatchRoot("a"), path.MatchRoot("b")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindRequiredTogether,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// Sequence returns a validator which ensures that the configuration validates
+// against all the given validators, in order. Validation stops at the first
+// validator which returns an error diagnostic, so later validators which
+// depend on the success of earlier validators do not return additional
+// errors. Warning diagnostics are kept.
+func Sequence(validators ...ephemeral.ConfigValidator) ephemeral.ConfigValidator {
+	return sequenceValidator{
+		validators: validators,
+	}
+}
+
+var _ ephemeral.ConfigValidator = sequenceValidator{}
+var _ validatorspec.ValidatorWithConstraint = sequenceValidator{}
+
+// sequenceValidator implements the validator.
+type sequenceValidator struct {
+	validators []ephemeral.ConfigValidator
+}
+
+// Description describes the validation in plain text formatting.
+func (v sequenceValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("Value must satisfy each of the validations in order: %s", strings.Join(descriptions, " then "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sequenceValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v sequenceValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindSequence,
+		Children: validatorspec.OfAll(ctx, v.validators),
+	}
+}
+
+// ValidateEphemeralResource performs the validation.
+func (v sequenceValidator) ValidateEphemeralResource(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	for _, subValidator := range v.validators {
+		validateResp := &ephemeral.ValidateConfigResponse{}
+
+		subValidator.ValidateEphemeralResource(ctx, req, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+)
+
+func ExampleSequence() {
+	// Used inside a ephemeral.EphemeralResource type ConfigValidators method
+	_ = []ephemeral.ConfigValidator{
+		// The configuration must satisfy each validator in order,
+		// stopping at the first validator which returns an error.
+		ephemeralvalidator.Sequence( /* ... */ ),
+	}
+}
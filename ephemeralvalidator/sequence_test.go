@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestSequenceValidatorValidateEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []ephemeral.ConfigValidator
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {},
+		"warnings": {
+			validators: []ephemeral.ConfigValidator{
+				testvalidator.WarningEphemeralResource("Warning 1", "warning 1 detail"),
+				testvalidator.WarningEphemeralResource("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewWarningDiagnostic("Warning 2", "warning 2 detail"),
+			},
+		},
+		"error-stops": {
+			validators: []ephemeral.ConfigValidator{
+				testvalidator.WarningEphemeralResource("Warning 1", "warning 1 detail"),
+				testvalidator.ErrorEphemeralResource("Error 1", "error 1 detail"),
+				testvalidator.ErrorEphemeralResource("Error 2", "error 2 detail"),
+				testvalidator.WarningEphemeralResource("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewErrorDiagnostic("Error 1", "error 1 detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &ephemeral.ValidateConfigResponse{}
+
+			ephemeralvalidator.Sequence(testCase.validators...).ValidateEphemeralResource(context.Background(), ephemeral.ValidateConfigRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSequenceValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := ephemeralvalidator.Sequence(
+		testvalidator.WarningEphemeralResource("Warning 1", "warning 1 detail"),
+		testvalidator.ErrorEphemeralResource("Error 1", "error 1 detail"),
+	)
+
+	expected := "Value must satisfy each of the validations in order: always returns a warning diagnostic then always returns an error diagnostic"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
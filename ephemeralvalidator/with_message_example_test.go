@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+)
+
+func ExampleWithMessage() {
+	// Used inside a ephemeral.EphemeralResource type ConfigValidators method
+	_ = []ephemeral.ConfigValidator{
+		// Replace the error diagnostic wording with domain-specific
+		// guidance for practitioners.
+		ephemeralvalidator.WithMessage(
+			ephemeralvalidator.All( /* ... */ ),
+			"Invalid Example Configuration",
+			"{{.Detail}} See the provider documentation for supported combinations.",
+		),
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+)
+
+func ExampleAnyBestMatch() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.Float32Attribute{
+				Required: true,
+				Validators: []validator.Float32{
+					// Validate this value passes at least one validator,
+					// otherwise returning only the errors of the closest match.
+					float32validator.AnyBestMatch( /* ... */ ),
+				},
+			},
+		},
+	}
+}
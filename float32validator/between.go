@@ -6,16 +6,20 @@ package float32validator
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.Float32 = betweenValidator{}
 var _ function.Float32ParameterValidator = betweenValidator{}
+var _ validatorspec.ValidatorWithConstraint = betweenValidator{}
 
 type betweenValidator struct {
 	min, max float32
@@ -30,7 +34,15 @@ func (validator betweenValidator) Description(_ context.Context) string {
 }
 
 func (validator betweenValidator) MarkdownDescription(ctx context.Context) string {
-	return validator.Description(ctx)
+	return validatormarkdown.Describe(ctx, validator)
+}
+
+func (validator betweenValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindRange,
+		Min:  big.NewFloat(float64(validator.min)),
+		Max:  big.NewFloat(float64(validator.max)),
+	}
 }
 
 func (v betweenValidator) ValidateFloat32(ctx context.Context, request validator.Float32Request, response *validator.Float32Response) {
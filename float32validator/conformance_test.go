@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Float32{
+		"AtLeast":            float32validator.AtLeast(1),
+		"AtMost":             float32validator.AtMost(1),
+		"Between":            float32validator.Between(-1, 1),
+		"OneOf":              float32validator.OneOf(1, 2),
+		"NoneOf":             float32validator.NoneOf(1, 2),
+		"All":                float32validator.All(float32validator.AtLeast(1), float32validator.AtMost(2)),
+		"Any":                float32validator.Any(float32validator.AtLeast(2), float32validator.AtMost(-2)),
+		"AnyWithAllWarnings": float32validator.AnyWithAllWarnings(float32validator.AtLeast(2), float32validator.AtMost(-2)),
+		"AsWarning":          float32validator.AsWarning(float32validator.AtLeast(1)),
+		"AnyBestMatch":       float32validator.AnyBestMatch(float32validator.AtLeast(2), float32validator.AtMost(-2)),
+		"Sequence":           float32validator.Sequence(float32validator.AtLeast(1)),
+		"Sensitive":          float32validator.Sensitive(float32validator.AtLeast(1)),
+		"WithMessage":        float32validator.WithMessage(float32validator.AtLeast(1), "Summary", "{{.Detail}}"),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: float32validator.All(float32validator.AtLeast(1.5), float32validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: float32validator.Any(float32validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: float32validator.AnyBestMatch(float32validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: float32validator.AnyWithAllWarnings(float32validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: float32validator.AsWarning(float32validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"Sensitive": {
+			validator: float32validator.Sensitive(float32validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Min:  big.NewFloat(1.5),
+			},
+		},
+		"Sequence": {
+			validator: float32validator.Sequence(float32validator.AtLeast(1.5), float32validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: float32validator.WithMessage(float32validator.AtLeast(1.5), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Min:  big.NewFloat(1.5),
+			},
+		},
+		"AtMost": {
+			validator: float32validator.AtMost(2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Max:  big.NewFloat(2),
+			},
+		},
+		"Between": {
+			validator: float32validator.Between(1, 2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Min:  big.NewFloat(1),
+				Max:  big.NewFloat(2),
+			},
+		},
+		"OneOf": {
+			validator: float32validator.OneOf(1, 2),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindOneOf,
+				Values: validatorspec.Values([]types.Float32{types.Float32Value(1), types.Float32Value(2)}),
+			},
+		},
+		"NoneOf": {
+			validator: float32validator.NoneOf(1, 2),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindNoneOf,
+				Values: validatorspec.Values([]types.Float32{types.Float32Value(1), types.Float32Value(2)}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
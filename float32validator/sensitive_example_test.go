@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+)
+
+func ExampleSensitive() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.Float32Attribute{
+				Required: true,
+				Validators: []validator.Float32{
+					// Replace the configured value in any diagnostics, such as for
+					// secrets in write-only attributes.
+					float32validator.Sensitive(
+						float32validator.All( /* ... */ ),
+					),
+				},
+			},
+		},
+	}
+}
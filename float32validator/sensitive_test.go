@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestSensitiveValidatorValidateFloat32(t *testing.T) {
+	t.Parallel()
+
+	value := types.Float32Value(1.5)
+
+	type testCase struct {
+		validator validator.Float32
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorFloat32("Error Summary", "value must be valid, got: "+value.String()),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"value must be valid, got: (sensitive value)",
+				),
+			},
+		},
+		"no-value": {
+			validator: testvalidator.ErrorFloat32("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: float32validator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.Float32Request{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    value,
+			}
+			response := validator.Float32Response{}
+			float32validator.Sensitive(test.validator).ValidateFloat32(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSensitiveValidatorValidateParameterFloat32(t *testing.T) {
+	t.Parallel()
+
+	value := types.Float32Value(1.5)
+
+	request := function.Float32ParameterValidatorRequest{
+		ArgumentPosition: 0,
+		Value:            value,
+	}
+	response := function.Float32ParameterValidatorResponse{}
+	float32validator.Sensitive(
+		testvalidator.ErrorFloat32("Error Summary", "value must be valid, got: "+value.String()),
+	).ValidateParameterFloat32(context.Background(), request, &response)
+
+	expected := function.NewArgumentFuncError(0, "Error Summary: value must be valid, got: (sensitive value)")
+
+	if diff := cmp.Diff(response.Error, expected); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+}
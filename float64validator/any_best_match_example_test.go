@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleAnyBestMatch() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.Float64Attribute{
+				Required: true,
+				Validators: []validator.Float64{
+					// Validate this value passes at least one validator,
+					// otherwise returning only the errors of the closest match.
+					float64validator.AnyBestMatch( /* ... */ ),
+				},
+			},
+		},
+	}
+}
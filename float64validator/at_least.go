@@ -6,16 +6,20 @@ package float64validator
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.Float64 = atLeastValidator{}
 var _ function.Float64ParameterValidator = atLeastValidator{}
+var _ validatorspec.ValidatorWithConstraint = atLeastValidator{}
 
 type atLeastValidator struct {
 	min float64
@@ -26,7 +30,14 @@ func (validator atLeastValidator) Description(_ context.Context) string {
 }
 
 func (validator atLeastValidator) MarkdownDescription(ctx context.Context) string {
-	return validator.Description(ctx)
+	return validatormarkdown.Describe(ctx, validator)
+}
+
+func (validator atLeastValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindRange,
+		Min:  big.NewFloat(float64(validator.min)),
+	}
 }
 
 func (validator atLeastValidator) ValidateFloat64(ctx context.Context, request validator.Float64Request, response *validator.Float64Response) {
@@ -6,16 +6,20 @@ package float64validator
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.Float64 = atMostValidator{}
 var _ function.Float64ParameterValidator = atMostValidator{}
+var _ validatorspec.ValidatorWithConstraint = atMostValidator{}
 
 type atMostValidator struct {
 	max float64
@@ -26,7 +30,14 @@ func (validator atMostValidator) Description(_ context.Context) string {
 }
 
 func (validator atMostValidator) MarkdownDescription(ctx context.Context) string {
-	return validator.Description(ctx)
+	return validatormarkdown.Describe(ctx, validator)
+}
+
+func (validator atMostValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindRange,
+		Max:  big.NewFloat(float64(validator.max)),
+	}
 }
 
 func (v atMostValidator) ValidateFloat64(ctx context.Context, request validator.Float64Request, response *validator.Float64Response) {
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Float64{
+		"AtLeast":            float64validator.AtLeast(1),
+		"AtMost":             float64validator.AtMost(1),
+		"Between":            float64validator.Between(-1, 1),
+		"OneOf":              float64validator.OneOf(1, 2),
+		"NoneOf":             float64validator.NoneOf(1, 2),
+		"All":                float64validator.All(float64validator.AtLeast(1), float64validator.AtMost(2)),
+		"Any":                float64validator.Any(float64validator.AtLeast(2), float64validator.AtMost(-2)),
+		"AnyWithAllWarnings": float64validator.AnyWithAllWarnings(float64validator.AtLeast(2), float64validator.AtMost(-2)),
+		"AsWarning":          float64validator.AsWarning(float64validator.AtLeast(1)),
+		"AnyBestMatch":       float64validator.AnyBestMatch(float64validator.AtLeast(2), float64validator.AtMost(-2)),
+		"Sequence":           float64validator.Sequence(float64validator.AtLeast(1)),
+		"Sensitive":          float64validator.Sensitive(float64validator.AtLeast(1)),
+		"WithMessage":        float64validator.WithMessage(float64validator.AtLeast(1), "Summary", "{{.Detail}}"),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: float64validator.All(float64validator.AtLeast(1.5), float64validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: float64validator.Any(float64validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: float64validator.AnyBestMatch(float64validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: float64validator.AnyWithAllWarnings(float64validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: float64validator.AsWarning(float64validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"Sensitive": {
+			validator: float64validator.Sensitive(float64validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Min:  big.NewFloat(1.5),
+			},
+		},
+		"Sequence": {
+			validator: float64validator.Sequence(float64validator.AtLeast(1.5), float64validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: float64validator.WithMessage(float64validator.AtLeast(1.5), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Min:  big.NewFloat(1.5),
+			},
+		},
+		"AtMost": {
+			validator: float64validator.AtMost(2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Max:  big.NewFloat(2),
+			},
+		},
+		"Between": {
+			validator: float64validator.Between(1, 2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Min:  big.NewFloat(1),
+				Max:  big.NewFloat(2),
+			},
+		},
+		"OneOf": {
+			validator: float64validator.OneOf(1, 2),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindOneOf,
+				Values: validatorspec.Values([]types.Float64{types.Float64Value(1), types.Float64Value(2)}),
+			},
+		},
+		"NoneOf": {
+			validator: float64validator.NoneOf(1, 2),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindNoneOf,
+				Values: validatorspec.Values([]types.Float64{types.Float64Value(1), types.Float64Value(2)}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
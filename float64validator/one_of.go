@@ -13,21 +13,31 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.Float64 = oneOfValidator{}
 var _ function.Float64ParameterValidator = oneOfValidator{}
+var _ validatorspec.ValidatorWithConstraint = oneOfValidator{}
 
 type oneOfValidator struct {
 	values []types.Float64
 }
 
-func (v oneOfValidator) Description(ctx context.Context) string {
-	return v.MarkdownDescription(ctx)
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %q", v.values)
 }
 
-func (v oneOfValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("value must be one of: %q", v.values)
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v oneOfValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindOneOf,
+		Values: validatorspec.Values(v.values),
+	}
 }
 
 func (v oneOfValidator) ValidateFloat64(ctx context.Context, request validator.Float64Request, response *validator.Float64Response) {
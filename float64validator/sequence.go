@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// Sequence returns a validator which ensures that any configured attribute
+// value validates against all the given validators, in order. Validation
+// stops at the first validator which returns an error diagnostic, so later
+// validators which depend on the success of earlier validators do not
+// return additional errors. Warning diagnostics are kept.
+//
+// When used with function parameters, each of the given validators must also
+// implement function.Float64ParameterValidator.
+func Sequence(validators ...validator.Float64) sequenceValidator {
+	return sequenceValidator{
+		validators: validators,
+	}
+}
+
+var _ validator.Float64 = sequenceValidator{}
+var _ function.Float64ParameterValidator = sequenceValidator{}
+var _ validatorspec.ValidatorWithConstraint = sequenceValidator{}
+
+// sequenceValidator implements the validator.
+type sequenceValidator struct {
+	validators []validator.Float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v sequenceValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("Value must satisfy each of the validations in order: %s", strings.Join(descriptions, " then "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sequenceValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v sequenceValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindSequence,
+		Children: validatorspec.OfAll(ctx, v.validators),
+	}
+}
+
+// ValidateFloat64 performs the validation.
+func (v sequenceValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	for _, subValidator := range v.validators {
+		validateResp := &validator.Float64Response{}
+
+		subValidator.ValidateFloat64(ctx, req, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if validateResp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// ValidateParameterFloat64 performs the validation.
+func (v sequenceValidator) ValidateParameterFloat64(ctx context.Context, req function.Float64ParameterValidatorRequest, resp *function.Float64ParameterValidatorResponse) {
+	for index, subValidator := range v.validators {
+		parameterValidator, ok := subValidator.(function.Float64ParameterValidator)
+
+		if !ok {
+			resp.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+				req.ArgumentPosition,
+				"Sequence",
+				fmt.Sprintf("the validator at index %d does not implement function.Float64ParameterValidator", index),
+			)
+
+			return
+		}
+
+		validateResp := &function.Float64ParameterValidatorResponse{}
+
+		parameterValidator.ValidateParameterFloat64(ctx, req, validateResp)
+
+		if validateResp.Error != nil {
+			resp.Error = validateResp.Error
+
+			return
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestSequenceValidatorValidateFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.Float64
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {},
+		"warnings": {
+			validators: []validator.Float64{
+				testvalidator.WarningFloat64("Warning 1", "warning 1 detail"),
+				testvalidator.WarningFloat64("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewWarningDiagnostic("Warning 2", "warning 2 detail"),
+			},
+		},
+		"error-stops": {
+			validators: []validator.Float64{
+				testvalidator.WarningFloat64("Warning 1", "warning 1 detail"),
+				testvalidator.ErrorFloat64("Error 1", "error 1 detail"),
+				testvalidator.ErrorFloat64("Error 2", "error 2 detail"),
+				testvalidator.WarningFloat64("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error 1", "error 1 detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Float64Request{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.Float64Response{}
+
+			float64validator.Sequence(testCase.validators...).ValidateFloat64(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSequenceValidatorValidateParameterFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.Float64
+		expected   *function.FuncError
+	}{
+		"no-validators": {},
+		"error-stops": {
+			validators: []validator.Float64{
+				testvalidator.ErrorFloat64("Error 1", "error 1 detail"),
+				testvalidator.ErrorFloat64("Error 2", "error 2 detail"),
+			},
+			expected: function.NewArgumentFuncError(0, "Error 1: error 1 detail"),
+		},
+		"error-before-unsupported": {
+			validators: []validator.Float64{
+				testvalidator.ErrorFloat64("Error 1", "error 1 detail"),
+				testvalidator.WarningFloat64("Warning 1", "warning 1 detail"),
+			},
+			expected: function.NewArgumentFuncError(0, "Error 1: error 1 detail"),
+		},
+		"unsupported": {
+			validators: []validator.Float64{
+				testvalidator.WarningFloat64("Warning 1", "warning 1 detail"),
+				testvalidator.ErrorFloat64("Error 1", "error 1 detail"),
+			},
+			expected: validatorfuncerr.InvalidValidatorUsageFuncError(
+				0,
+				"Sequence",
+				"the validator at index 0 does not implement function.Float64ParameterValidator",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := function.Float64ParameterValidatorRequest{
+				ArgumentPosition: 0,
+			}
+			resp := &function.Float64ParameterValidatorResponse{}
+
+			float64validator.Sequence(testCase.validators...).ValidateParameterFloat64(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Error); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSequenceValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := float64validator.Sequence(
+		testvalidator.WarningFloat64("Warning 1", "warning 1 detail"),
+		testvalidator.ErrorFloat64("Error 1", "error 1 detail"),
+	)
+
+	expected := "Value must satisfy each of the validations in order: always returns a warning diagnostic then always returns an error diagnostic"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorcache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+var benchmarkSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Optional: true,
+		},
+		"prefix": schema.StringAttribute{
+			Optional: true,
+		},
+		"total": schema.Int64Attribute{
+			Optional: true,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"rule": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"description": schema.StringAttribute{
+						Optional: true,
+					},
+					"port": schema.Int64Attribute{
+						Optional: true,
+					},
+					"protocol": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	},
+}
+
+// benchmarkConfig returns a configuration with the number of rule blocks.
+func benchmarkConfig(b *testing.B, rules int) tfsdk.Config {
+	b.Helper()
+
+	values := make([]any, 0, rules)
+
+	for i := range rules {
+		values = append(values, map[string]any{
+			"description": fmt.Sprintf("rule %d", i),
+			"port":        i,
+		})
+	}
+
+	return validatortest.Config(b, benchmarkSchema, map[string]any{
+		"name":  "example",
+		"rule":  values,
+		"total": rules * rules,
+	})
+}
+
+// benchmarkValidation runs the validation of a request for configurations
+// of increasing sizes, with and without a cache for each request.
+func benchmarkValidation(b *testing.B, validate func(ctx context.Context, config tfsdk.Config, rules int)) {
+	for _, rules := range []int{10, 50, 250} {
+		config := benchmarkConfig(b, rules)
+
+		b.Run(fmt.Sprintf("rules=%d/no-cache", rules), func(b *testing.B) {
+			for b.Loop() {
+				validate(context.Background(), config, rules)
+			}
+		})
+
+		b.Run(fmt.Sprintf("rules=%d/cache", rules), func(b *testing.B) {
+			for b.Loop() {
+				validate(validatorcache.WithCache(context.Background()), config, rules)
+			}
+		})
+	}
+}
+
+// BenchmarkConflictsWith validates the description of each rule, which
+// conflicts with the prefix and the protocols of all rules.
+func BenchmarkConflictsWith(b *testing.B) {
+	v := stringvalidator.ConflictsWith(
+		path.MatchRoot("prefix"),
+		path.MatchRoot("rule").AtAnyListIndex().AtName("protocol"),
+	)
+
+	benchmarkValidation(b, func(ctx context.Context, config tfsdk.Config, rules int) {
+		for i := range rules {
+			p := path.Root("rule").AtListIndex(i).AtName("description")
+
+			req := validator.StringRequest{
+				Config:         config,
+				ConfigValue:    types.StringValue(fmt.Sprintf("rule %d", i)),
+				Path:           p,
+				PathExpression: p.Expression(),
+			}
+
+			v.ValidateString(ctx, req, &validator.StringResponse{})
+		}
+	})
+}
+
+// BenchmarkAtMostSumOf validates the port of each rule, which must be at
+// most the sum of the total and the ports of all rules.
+func BenchmarkAtMostSumOf(b *testing.B) {
+	v := int64validator.AtMostSumOf(
+		path.MatchRoot("total"),
+		path.MatchRoot("rule").AtAnyListIndex().AtName("port"),
+	)
+
+	benchmarkValidation(b, func(ctx context.Context, config tfsdk.Config, rules int) {
+		for i := range rules {
+			p := path.Root("rule").AtListIndex(i).AtName("port")
+
+			req := validator.Int64Request{
+				Config:         config,
+				ConfigValue:    types.Int64Value(int64(i)),
+				Path:           p,
+				PathExpression: p.Expression(),
+			}
+
+			v.ValidateInt64(ctx, req, &validator.Int64Response{})
+		}
+	})
+}
+
+// BenchmarkConfigValidators validates the configuration with resource
+// configuration validators referencing the same attributes.
+func BenchmarkConfigValidators(b *testing.B) {
+	validators := []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("name"),
+			path.MatchRoot("rule").AtAnyListIndex().AtName("description"),
+		),
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("rule").AtAnyListIndex().AtName("description"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("total"),
+			path.MatchRoot("rule").AtAnyListIndex().AtName("port"),
+		),
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("prefix"),
+			path.MatchRoot("rule").AtAnyListIndex().AtName("port"),
+		),
+	}
+
+	benchmarkValidation(b, func(ctx context.Context, config tfsdk.Config, _ int) {
+		req := resource.ValidateConfigRequest{
+			Config: config,
+		}
+
+		for _, v := range validators {
+			v.ValidateResource(ctx, req, &resource.ValidateConfigResponse{})
+		}
+	})
+}
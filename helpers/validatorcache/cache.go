@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorcache
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// contextKey is the context key of the cache.
+type contextKey struct{}
+
+// cache is the path resolution cache of a single configuration. It is safe
+// for concurrent use.
+type cache struct {
+	mu          sync.Mutex
+	pathMatches map[string]pathMatchesResult
+	attributes  map[string]attributeResult
+}
+
+type pathMatchesResult struct {
+	paths path.Paths
+	diags diag.Diagnostics
+}
+
+type attributeResult struct {
+	value attr.Value
+	diags diag.Diagnostics
+}
+
+// WithCache returns a context with a new, empty cache, for the validators of
+// a single validation request. Results are cached by path expression or path
+// only, so the context must only be used with a single configuration.
+func WithCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, &cache{
+		pathMatches: make(map[string]pathMatchesResult),
+		attributes:  make(map[string]attributeResult),
+	})
+}
+
+// fromContext returns the cache of the context, if any.
+func fromContext(ctx context.Context) (*cache, bool) {
+	c, ok := ctx.Value(contextKey{}).(*cache)
+
+	return c, ok
+}
+
+// PathMatches returns the paths of the configuration matching the path
+// expression, as returned by the PathMatches method of the configuration.
+// If the context has a cache, the expression is only resolved the first
+// time.
+func PathMatches(ctx context.Context, config tfsdk.Config, expression path.Expression) (path.Paths, diag.Diagnostics) {
+	c, ok := fromContext(ctx)
+
+	if !ok {
+		return config.PathMatches(ctx, expression)
+	}
+
+	key := expression.String()
+
+	c.mu.Lock()
+	result, ok := c.pathMatches[key]
+	c.mu.Unlock()
+
+	if !ok {
+		result.paths, result.diags = config.PathMatches(ctx, expression)
+
+		c.mu.Lock()
+		c.pathMatches[key] = result
+		c.mu.Unlock()
+	}
+
+	return slices.Clone(result.paths), slices.Clone(result.diags)
+}
+
+// GetAttribute returns the value of the configuration at the path, as
+// returned by the GetAttribute method of the configuration with an
+// attr.Value target. If the context has a cache, the value is only
+// retrieved the first time.
+func GetAttribute(ctx context.Context, config tfsdk.Config, p path.Path) (attr.Value, diag.Diagnostics) {
+	c, ok := fromContext(ctx)
+
+	if !ok {
+		var value attr.Value
+
+		diags := config.GetAttribute(ctx, p, &value)
+
+		return value, diags
+	}
+
+	key := p.String()
+
+	c.mu.Lock()
+	result, ok := c.attributes[key]
+	c.mu.Unlock()
+
+	if !ok {
+		result.diags = config.GetAttribute(ctx, p, &result.value)
+
+		c.mu.Lock()
+		c.attributes[key] = result
+		c.mu.Unlock()
+	}
+
+	return result.value, slices.Clone(result.diags)
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorcache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Optional: true,
+		},
+		"tags": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	},
+}
+
+func TestPathMatches(t *testing.T) {
+	t.Parallel()
+
+	config := validatortest.Config(t, testSchema, map[string]any{
+		"name": "example",
+		"tags": []any{"a", "b"},
+	})
+
+	testCases := map[string]struct {
+		ctx           context.Context
+		expression    path.Expression
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"no-cache": {
+			ctx:        context.Background(),
+			expression: path.MatchRoot("tags").AtAnyListIndex(),
+			expected: path.Paths{
+				path.Root("tags").AtListIndex(0),
+				path.Root("tags").AtListIndex(1),
+			},
+		},
+		"cache": {
+			ctx:        validatorcache.WithCache(context.Background()),
+			expression: path.MatchRoot("tags").AtAnyListIndex(),
+			expected: path.Paths{
+				path.Root("tags").AtListIndex(0),
+				path.Root("tags").AtListIndex(1),
+			},
+		},
+		"cache-invalid-expression": {
+			ctx:        validatorcache.WithCache(context.Background()),
+			expression: path.MatchRoot("missing"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: missing",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Cached results must equal the resolved results.
+			for range 2 {
+				got, diags := validatorcache.PathMatches(testCase.ctx, config, testCase.expression)
+
+				if diff := cmp.Diff(testCase.expected, got); diff != "" {
+					t.Errorf("unexpected difference: %s", diff)
+				}
+
+				if diff := cmp.Diff(testCase.expectedDiags, diags); diff != "" {
+					t.Errorf("unexpected diagnostics difference: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPathMatches_CacheScope(t *testing.T) {
+	t.Parallel()
+
+	ctx := validatorcache.WithCache(context.Background())
+	expression := path.MatchRoot("tags").AtAnyListIndex()
+
+	first := validatortest.Config(t, testSchema, map[string]any{
+		"tags": []any{"a"},
+	})
+	second := validatortest.Config(t, testSchema, map[string]any{
+		"tags": []any{"a", "b"},
+	})
+
+	_, _ = validatorcache.PathMatches(ctx, first, expression)
+
+	// The cache is scoped to the context rather than the configuration.
+	got, _ := validatorcache.PathMatches(ctx, second, expression)
+
+	expected := path.Paths{
+		path.Root("tags").AtListIndex(0),
+	}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	got, _ = validatorcache.PathMatches(validatorcache.WithCache(context.Background()), second, expression)
+
+	expected = path.Paths{
+		path.Root("tags").AtListIndex(0),
+		path.Root("tags").AtListIndex(1),
+	}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestGetAttribute(t *testing.T) {
+	t.Parallel()
+
+	config := validatortest.Config(t, testSchema, map[string]any{
+		"name": "example",
+	})
+
+	testCases := map[string]struct {
+		ctx           context.Context
+		path          path.Path
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"no-cache": {
+			ctx:      context.Background(),
+			path:     path.Root("name"),
+			expected: types.StringValue("example"),
+		},
+		"cache": {
+			ctx:      validatorcache.WithCache(context.Background()),
+			path:     path.Root("name"),
+			expected: types.StringValue("example"),
+		},
+		"cache-null": {
+			ctx:      validatorcache.WithCache(context.Background()),
+			path:     path.Root("tags"),
+			expected: types.ListNull(types.StringType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Cached results must equal the retrieved results.
+			for range 2 {
+				got, diags := validatorcache.GetAttribute(testCase.ctx, config, testCase.path)
+
+				if diff := cmp.Diff(testCase.expected, got); diff != "" {
+					t.Errorf("unexpected difference: %s", diff)
+				}
+
+				if diff := cmp.Diff(testCase.expectedDiags, diags); diff != "" {
+					t.Errorf("unexpected diagnostics difference: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validatorcache caches the path expression matches and attribute
+// values of a configuration for the validators of a single validation
+// request, such as those of a ValidateResourceConfig RPC.
+//
+// Path validators, such as stringvalidator.ConflictsWith,
+// resourcevalidator.ExactlyOneOf, and int64validator.AtLeastSumOf, resolve
+// their path expressions against the configuration for every attribute
+// they are applied to. For large configurations, such as those of nested
+// blocks with many elements, the same expressions are otherwise resolved
+// many times.
+//
+// The cache is scoped to a context returned by WithCache. Validators which
+// are called with the context, or a context derived from it, share the
+// cache, while validators called without it resolve expressions directly
+// against the configuration. The framework calls validators with the
+// context of the RPC, so providers enable the cache by wrapping the provider
+// server returned by providerserver.NewProtocol6, or NewProtocol5, and
+// calling each validation RPC with a new cache:
+//
+//	type cachingServer struct {
+//		tfprotov6.ProviderServer
+//	}
+//
+//	func (s cachingServer) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+//		return s.ProviderServer.ValidateResourceConfig(validatorcache.WithCache(ctx), req)
+//	}
+//
+// The other validation RPCs, such as ValidateDataResourceConfig and
+// ValidateProviderConfig, are wrapped the same way. Embedding the
+// tfprotov6.ProviderServer interface hides the optional RPCs of the framework
+// server, such as those of actions and list resources, so providers which
+// implement them should embed an interface which includes them, such as
+// tfprotov6.ProviderServerWithActions.
+//
+// Alternatively, provider-defined validators, such as the ValidateConfig
+// method of a resource, can call the validators of this module with a
+// context returned by WithCache.
+//
+// Results are cached by the string representation of the path expression or
+// path alone, not by the configuration they were resolved against. As the
+// cached results are only valid for a single configuration, a context
+// returned by WithCache must not be shared across requests or used with
+// more than one configuration, such as those of different resources.
+//
+// Provider-defined validators can share the cache by calling PathMatches
+// and GetAttribute instead of the methods of tfsdk.Config.
+package validatorcache
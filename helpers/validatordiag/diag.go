@@ -5,6 +5,7 @@ package validatordiag
 
 import (
 	"fmt"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 
@@ -12,6 +13,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
+// RedactedValue replaces sensitive values in diagnostics, such as those of
+// validators wrapped with the Sensitive function of each typed validator
+// package.
+const RedactedValue = "(sensitive value)"
+
 // InvalidBlockDiagnostic returns an error Diagnostic to be used when a block is invalid
 func InvalidBlockDiagnostic(path path.Path, description string) diag.Diagnostic {
 	return diag.NewAttributeErrorDiagnostic(
@@ -85,6 +91,96 @@ func InvalidValidatorUsageDiagnostic(path path.Path, validatorName string, descr
 	)
 }
 
+// DeprecatedAttributeValueDiagnostic returns a warning Diagnostic to be used when an attribute has a deprecated value.
+func DeprecatedAttributeValueDiagnostic(path path.Path, value string, message string) diag.Diagnostic {
+	return diag.NewAttributeWarningDiagnostic(
+		path,
+		"Deprecated Attribute Value",
+		fmt.Sprintf("Attribute %s value %s is deprecated: %s", path, value, message),
+	)
+}
+
+// RemovedAttributeValueDiagnostic returns an error Diagnostic to be used when an attribute has a deprecated value which is no longer supported.
+func RemovedAttributeValueDiagnostic(path path.Path, value string, message string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Unsupported Attribute Value",
+		fmt.Sprintf("Attribute %s value %s is no longer supported: %s", path, value, message),
+	)
+}
+
+// PossibleSecretDiagnostic returns a Diagnostic with the given severity to be used when an attribute value resembles a credential.
+// The fragment should be masked, so the diagnostic does not include the credential.
+func PossibleSecretDiagnostic(path path.Path, severity diag.Severity, kind string, fragment string) diag.Diagnostic {
+	summary := "Possible Secret in Attribute Value"
+	detail := fmt.Sprintf("Attribute %s value appears to contain %s (%s). "+
+		"This attribute is not sensitive, so its value is shown in plan output and stored in state. "+
+		"Credentials should only be configured in sensitive or write-only attributes.",
+		path,
+		kind,
+		fragment,
+	)
+
+	if severity == diag.SeverityError {
+		return diag.NewAttributeErrorDiagnostic(path, summary, detail)
+	}
+
+	return diag.NewAttributeWarningDiagnostic(path, summary, detail)
+}
+
+// ValueSourceErrorDiagnostic returns an error Diagnostic to be used when a validator is unable to determine the values to validate against.
+func ValueSourceErrorDiagnostic(path path.Path, validatorName string, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Unable to Determine Allowed Values",
+		fmt.Sprintf("When validating attribute %s, the allowed values could not be determined. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			"The value source of the %q validator returned an error: %s",
+			path,
+			validatorName,
+			err,
+		),
+	)
+}
+
+// NoMatchingValidatorDiagnostic returns an error Diagnostic to be used when an attribute value satisfies none of the described validations.
+// The error diagnostics of the closest matching validation are included, rather than those of every validation.
+func NoMatchingValidatorDiagnostic(path path.Path, descriptions []string, closestDescription string, closestDiags diag.Diagnostics) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s value must satisfy one of: %s\n\n%s", path, strings.Join(descriptions, ", "), closestMatch(closestDescription, closestDiags)),
+	)
+}
+
+// NoMatchingConfigValidatorDiagnostic returns an error Diagnostic to be used when a configuration satisfies none of the described validations.
+// The error diagnostics of the closest matching validation are included, rather than those of every validation.
+func NoMatchingConfigValidatorDiagnostic(descriptions []string, closestDescription string, closestDiags diag.Diagnostics) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Configuration",
+		fmt.Sprintf("Configuration must satisfy one of: %s\n\n%s", strings.Join(descriptions, ", "), closestMatch(closestDescription, closestDiags)),
+	)
+}
+
+// closestMatch describes the error diagnostics of the closest matching validation.
+func closestMatch(description string, diags diag.Diagnostics) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "The closest match, %q, failed with:", description)
+
+	for _, d := range diags.Errors() {
+		detail := d.Detail()
+
+		if detail == "" {
+			detail = d.Summary()
+		}
+
+		b.WriteString("\n  - " + detail)
+	}
+
+	return b.String()
+}
+
 // capitalize will uppercase the first letter in a UTF-8 string.
 func capitalize(str string) string {
 	if str == "" {
@@ -5,6 +5,10 @@ package validatordiag
 
 import (
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 func TestCapitalize(t *testing.T) {
@@ -45,3 +49,54 @@ func TestCapitalize(t *testing.T) {
 		})
 	}
 }
+
+func TestNoMatchingValidatorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	got := NoMatchingValidatorDiagnostic(
+		path.Root("test"),
+		[]string{"value must be one of: [\"a\"]", "string length must be at least 4"},
+		"string length must be at least 4",
+		diag.Diagnostics{
+			diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning", "warning detail"),
+			diag.NewAttributeErrorDiagnostic(path.Root("test"), "Invalid Attribute Value Length", "Attribute test string length must be at least 4, got: 3"),
+			diag.NewErrorDiagnostic("Error Without Detail", ""),
+		},
+	)
+
+	expected := diag.NewAttributeErrorDiagnostic(
+		path.Root("test"),
+		"Invalid Attribute Value",
+		"Attribute test value must satisfy one of: value must be one of: [\"a\"], string length must be at least 4\n\n"+
+			"The closest match, \"string length must be at least 4\", failed with:\n"+
+			"  - Attribute test string length must be at least 4, got: 3\n"+
+			"  - Error Without Detail",
+	)
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected diagnostic difference: %s", diff)
+	}
+}
+
+func TestNoMatchingConfigValidatorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	got := NoMatchingConfigValidatorDiagnostic(
+		[]string{"a", "b"},
+		"b",
+		diag.Diagnostics{
+			diag.NewErrorDiagnostic("Invalid Attribute Combination", "b detail"),
+		},
+	)
+
+	expected := diag.NewErrorDiagnostic(
+		"Invalid Configuration",
+		"Configuration must satisfy one of: a, b\n\n"+
+			"The closest match, \"b\", failed with:\n"+
+			"  - b detail",
+	)
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected diagnostic difference: %s", diff)
+	}
+}
@@ -43,3 +43,18 @@ func InvalidValidatorUsageFuncError(argumentPosition int64, validatorName string
 		),
 	)
 }
+
+// ValueSourceFuncError returns a function error to be used when a validator is unable to determine the values to validate against.
+func ValueSourceFuncError(argumentPosition int64, validatorName string, err error) *function.FuncError {
+	return function.NewArgumentFuncError(
+		argumentPosition,
+		fmt.Sprintf(
+			"Unable to Determine Allowed Values: "+
+				"When validating the function parameter, the allowed values could not be determined. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"The value source of the %q validator returned an error: %s",
+			validatorName,
+			err,
+		),
+	)
+}
@@ -0,0 +1,506 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorjsonschema
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// scope is the object containing an attribute or block, onto which path
+// validators of the attribute or block are mapped.
+type scope struct {
+	// object is the schema of the object.
+	object *Schema
+
+	// expression is the expression of the attribute or block, or empty for
+	// configuration validators.
+	expression path.Expression
+
+	// name is the name of the attribute or block, or empty for
+	// configuration validators.
+	name string
+}
+
+// siblings returns the names of the attributes of the object matched by
+// the expressions, or false if any expression matches other attributes.
+func (s *scope) siblings(expressions path.Expressions) ([]string, bool) {
+	objectSteps := s.expression.Steps()
+
+	if s.name != "" {
+		objectSteps = objectSteps[:len(objectSteps)-1]
+	}
+
+	names := make([]string, 0, len(expressions))
+
+	for _, expression := range expressions {
+		steps := s.expression.Merge(expression).Resolve().Steps()
+
+		if len(steps) != len(objectSteps)+1 || !steps[:len(objectSteps)].Equal(objectSteps) {
+			return nil, false
+		}
+
+		name, ok := steps[len(steps)-1].(path.ExpressionStepAttributeNameExact)
+
+		if !ok {
+			return nil, false
+		}
+
+		names = append(names, string(name))
+	}
+
+	return names, len(names) > 0
+}
+
+// applyOrExtend applies the constraint to the schema of a value of the
+// type, or adds it to the extensions of the schema if it cannot be mapped.
+func (b builder) applyOrExtend(target *Schema, typ attr.Type, constraint validatorspec.Constraint, s *scope) {
+	if !b.apply(target, typ, constraint, s) {
+		target.Extensions = append(target.Extensions, b.extension(constraint))
+	}
+}
+
+// apply maps the constraint onto keywords of the schema of a value of the
+// type, and of the object of the scope for path validators, returning
+// false if it cannot be mapped. The type is nil for objects of nested
+// attributes and blocks, and of schemas, and the scope is nil when path
+// validators cannot be mapped.
+func (b builder) apply(target *Schema, typ attr.Type, constraint validatorspec.Constraint, s *scope) bool {
+	switch constraint.Kind {
+	case validatorspec.KindAll, validatorspec.KindSequence:
+		for _, child := range constraint.Children {
+			b.applyOrExtend(target, typ, child, s)
+		}
+
+		return true
+	case validatorspec.KindAny, validatorspec.KindAnyWithAllWarnings:
+		if len(constraint.Children) == 0 {
+			return false
+		}
+
+		fragments := make([]*Schema, 0, len(constraint.Children))
+
+		for _, child := range constraint.Children {
+			fragment := &Schema{}
+
+			if !b.apply(fragment, typ, child, nil) {
+				return false
+			}
+
+			fragments = append(fragments, fragment)
+		}
+
+		constrain(target, func(s *Schema) bool { return s.AnyOf != nil }, func(s *Schema) { s.AnyOf = fragments })
+
+		return true
+	case validatorspec.KindEquals:
+		if len(constraint.Values) != 1 {
+			return false
+		}
+
+		value, ok := b.jsonValue(constraint.Values[0])
+
+		if !ok {
+			return false
+		}
+
+		constrain(target, func(s *Schema) bool { return s.Const != nil }, func(s *Schema) { s.Const = value })
+
+		return true
+	case validatorspec.KindOneOf, validatorspec.KindNoneOf:
+		if constraint.CaseInsensitive {
+			return false
+		}
+
+		values, ok := b.jsonValues(constraint.Values)
+
+		if !ok {
+			return false
+		}
+
+		if constraint.Kind == validatorspec.KindNoneOf {
+			constrain(target, func(s *Schema) bool { return s.Not != nil }, func(s *Schema) { s.Not = &Schema{Enum: values} })
+
+			return true
+		}
+
+		constrain(target, func(s *Schema) bool { return s.Enum != nil }, func(s *Schema) { s.Enum = values })
+
+		return true
+	case validatorspec.KindRange:
+		target.Minimum = stricterNumber(target.Minimum, constraint.Min, 1)
+		target.Maximum = stricterNumber(target.Maximum, constraint.Max, -1)
+
+		return true
+	case validatorspec.KindLength, validatorspec.KindUTF8Length:
+		target.MinLength = stricterCount(target.MinLength, constraint.Min, 1)
+		target.MaxLength = stricterCount(target.MaxLength, constraint.Max, -1)
+
+		return true
+	case validatorspec.KindSize:
+		switch {
+		case isArray(b.ctx, typ):
+			target.MinItems = stricterCount(target.MinItems, constraint.Min, 1)
+			target.MaxItems = stricterCount(target.MaxItems, constraint.Max, -1)
+		case isMap(b.ctx, typ):
+			target.MinProperties = stricterCount(target.MinProperties, constraint.Min, 1)
+			target.MaxProperties = stricterCount(target.MaxProperties, constraint.Max, -1)
+		default:
+			return false
+		}
+
+		return true
+	case validatorspec.KindUniqueValues:
+		if !isArray(b.ctx, typ) {
+			return false
+		}
+
+		target.UniqueItems = true
+
+		return true
+	case validatorspec.KindRegexMatches:
+		constrain(target, func(s *Schema) bool { return s.Pattern != "" }, func(s *Schema) { s.Pattern = constraint.Pattern })
+
+		return true
+	case validatorspec.KindFormat:
+		if constraint.Format != validatorspec.FormatRegex {
+			return false
+		}
+
+		constrain(target, func(s *Schema) bool { return s.Format != "" }, func(s *Schema) { s.Format = "regex" })
+
+		return true
+	case validatorspec.KindJSONSchema:
+		if !json.Valid([]byte(constraint.Schema)) {
+			return false
+		}
+
+		constrain(target, func(s *Schema) bool { return s.ContentSchema != nil }, func(s *Schema) {
+			s.ContentMediaType = "application/json"
+			s.ContentSchema = json.RawMessage(constraint.Schema)
+		})
+
+		return true
+	case validatorspec.KindElements:
+		elements := &target.Items
+
+		switch {
+		case isMap(b.ctx, typ):
+			elements = &target.AdditionalProperties
+		case !isArray(b.ctx, typ):
+			return false
+		}
+
+		if *elements == nil {
+			*elements = &Schema{}
+		}
+
+		for _, child := range constraint.Children {
+			b.applyOrExtend(*elements, elementType(typ), child, nil)
+		}
+
+		return true
+	case validatorspec.KindKeys:
+		if !isMap(b.ctx, typ) {
+			return false
+		}
+
+		if target.PropertyNames == nil {
+			target.PropertyNames = &Schema{}
+		}
+
+		for _, child := range constraint.Children {
+			b.applyOrExtend(target.PropertyNames, types.StringType, child, nil)
+		}
+
+		return true
+	case validatorspec.KindRequired:
+		if s == nil || s.name == "" {
+			return false
+		}
+
+		if !slices.Contains(s.object.Required, s.name) {
+			s.object.Required = append(s.object.Required, s.name)
+		}
+
+		return true
+	case validatorspec.KindAlsoRequires,
+		validatorspec.KindAtLeastOneOf,
+		validatorspec.KindConflicting,
+		validatorspec.KindConflictsWith,
+		validatorspec.KindExactlyOneOf,
+		validatorspec.KindRequiredTogether:
+		return b.applyPaths(constraint, s)
+	default:
+		return false
+	}
+}
+
+// applyPaths maps the constraint of a path validator onto keywords of the
+// object of the scope.
+func (b builder) applyPaths(constraint validatorspec.Constraint, s *scope) bool {
+	if s == nil {
+		return false
+	}
+
+	names, ok := s.siblings(constraint.Paths)
+
+	if !ok {
+		return false
+	}
+
+	// The attribute or block of the validator is also one of the
+	// attributes of validators other than AlsoRequires and ConflictsWith.
+	all := slices.Clone(names)
+
+	if s.name != "" {
+		all = append(all, s.name)
+	}
+
+	slices.Sort(all)
+	all = slices.Compact(all)
+
+	switch constraint.Kind {
+	case validatorspec.KindAlsoRequires:
+		if s.name == "" {
+			return false
+		}
+
+		if s.object.DependentRequired == nil {
+			s.object.DependentRequired = make(map[string][]string)
+		}
+
+		dependencies := append(s.object.DependentRequired[s.name], names...)
+
+		slices.Sort(dependencies)
+
+		s.object.DependentRequired[s.name] = slices.Compact(dependencies)
+	case validatorspec.KindAtLeastOneOf:
+		addObjectConstraint(s.object, &Schema{AnyOf: requiredEach(all)})
+	case validatorspec.KindConflicting:
+		for i, name := range all {
+			for _, other := range all[i+1:] {
+				addObjectConstraint(s.object, &Schema{Not: &Schema{Required: []string{name, other}}})
+			}
+		}
+	case validatorspec.KindConflictsWith:
+		if s.name == "" {
+			return false
+		}
+
+		for _, name := range names {
+			if name == s.name {
+				continue
+			}
+
+			pair := []string{s.name, name}
+
+			slices.Sort(pair)
+
+			addObjectConstraint(s.object, &Schema{Not: &Schema{Required: pair}})
+		}
+	case validatorspec.KindExactlyOneOf:
+		addObjectConstraint(s.object, &Schema{OneOf: requiredEach(all)})
+	case validatorspec.KindRequiredTogether:
+		addObjectConstraint(s.object, &Schema{
+			AnyOf: []*Schema{
+				{Required: all},
+				{Not: &Schema{AnyOf: requiredEach(all)}},
+			},
+		})
+	}
+
+	return true
+}
+
+// extension returns the extension of the constraint.
+func (b builder) extension(constraint validatorspec.Constraint) Extension {
+	result := Extension{
+		Kind:            constraint.Kind,
+		Description:     constraint.Description,
+		Format:          constraint.Format,
+		CaseInsensitive: constraint.CaseInsensitive,
+		Pattern:         constraint.Pattern,
+		Separator:       constraint.Separator,
+	}
+
+	result.Minimum, _ = jsonNumber(constraint.Min)
+	result.Maximum, _ = jsonNumber(constraint.Max)
+
+	for _, value := range constraint.Values {
+		if jsonValue, ok := b.jsonValue(value); ok {
+			result.Values = append(result.Values, jsonValue)
+		}
+	}
+
+	for _, expression := range constraint.Paths {
+		result.Paths = append(result.Paths, expression.String())
+	}
+
+	for _, child := range constraint.Children {
+		result.Children = append(result.Children, b.extension(child))
+	}
+
+	return result
+}
+
+// jsonValues returns the JSON values of the values, or false if any value
+// is not a known primitive value.
+func (b builder) jsonValues(values []attr.Value) ([]any, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	result := make([]any, 0, len(values))
+
+	for _, value := range values {
+		jsonValue, ok := b.jsonValue(value)
+
+		if !ok {
+			return nil, false
+		}
+
+		result = append(result, jsonValue)
+	}
+
+	return result, true
+}
+
+// jsonValue returns the JSON value of the value, or false if it is not a
+// known bool, number, or string value.
+func (b builder) jsonValue(value attr.Value) (any, bool) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil, false
+	}
+
+	tfValue, err := value.ToTerraformValue(b.ctx)
+
+	if err != nil {
+		return nil, false
+	}
+
+	switch {
+	case tfValue.Type().Is(tftypes.Bool):
+		var result bool
+
+		return result, tfValue.As(&result) == nil
+	case tfValue.Type().Is(tftypes.Number):
+		var result big.Float
+
+		if err := tfValue.As(&result); err != nil {
+			return nil, false
+		}
+
+		return jsonNumber(&result)
+	case tfValue.Type().Is(tftypes.String):
+		var result string
+
+		return result, tfValue.As(&result) == nil
+	default:
+		return nil, false
+	}
+}
+
+// jsonNumber returns the JSON number of the finite number, or false if it
+// is nil or infinite.
+func jsonNumber(number *big.Float) (json.Number, bool) {
+	if number == nil || number.IsInf() {
+		return "", false
+	}
+
+	if number.IsInt() {
+		return json.Number(number.Text('f', 0)), true
+	}
+
+	return json.Number(number.Text('g', -1)), true
+}
+
+// stricterNumber returns the stricter of the current and candidate bounds,
+// which is the greater for a sign of 1 and the lesser for a sign of -1.
+func stricterNumber(current json.Number, candidate *big.Float, sign int) json.Number {
+	number, ok := jsonNumber(candidate)
+
+	if !ok {
+		return current
+	}
+
+	if current == "" {
+		return number
+	}
+
+	currentFloat, _, err := big.ParseFloat(string(current), 10, 0, big.ToNearestEven)
+
+	if err != nil || candidate.Cmp(currentFloat) == sign {
+		return number
+	}
+
+	return current
+}
+
+// stricterCount returns the stricter of the current and candidate bounds of
+// lengths or element counts, which is the greater for a sign of 1 and the
+// lesser for a sign of -1.
+func stricterCount(current *int64, candidate *big.Float, sign int) *int64 {
+	if candidate == nil || candidate.IsInf() {
+		return current
+	}
+
+	count, _ := candidate.Int64()
+
+	if current == nil || (sign > 0 && count > *current) || (sign < 0 && count < *current) {
+		return &count
+	}
+
+	return current
+}
+
+// constrain sets a keyword of the target schema, or of a new schema in the
+// allOf of the target schema if the keyword is already set, so the
+// constraints of both are applied.
+func constrain(target *Schema, isSet func(*Schema) bool, set func(*Schema)) {
+	if !isSet(target) {
+		set(target)
+
+		return
+	}
+
+	fragment := &Schema{}
+
+	set(fragment)
+
+	target.AllOf = append(target.AllOf, fragment)
+}
+
+// addObjectConstraint adds the schema to the allOf of the object, unless an
+// equal schema was already added, such as by ExactlyOneOf validators of
+// each attribute.
+func addObjectConstraint(object *Schema, schema *Schema) {
+	for _, existing := range object.AllOf {
+		if reflect.DeepEqual(existing, schema) {
+			return
+		}
+	}
+
+	object.AllOf = append(object.AllOf, schema)
+}
+
+// requiredEach returns a schema requiring each of the names.
+func requiredEach(names []string) []*Schema {
+	result := make([]*Schema, 0, len(names))
+
+	for _, name := range names {
+		result = append(result, &Schema{Required: []string{name}})
+	}
+
+	return result
+}
@@ -0,0 +1,406 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorjsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorjsonschema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestFromResourceSchemaConstraints(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Attribute
+		expected  *validatorjsonschema.Schema
+	}{
+		"bool-equals": {
+			attribute: schema.BoolAttribute{
+				Optional:   true,
+				Validators: []validator.Bool{boolvalidator.Equals(false)},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type:  "boolean",
+				Const: false,
+			},
+		},
+		"float64-at-least": {
+			attribute: schema.Float64Attribute{
+				Optional:   true,
+				Validators: []validator.Float64{float64validator.AtLeast(1.5)},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type:    "number",
+				Minimum: "1.5",
+			},
+		},
+		"int64-between-at-most": {
+			attribute: schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+					int64validator.AtMost(10),
+					int64validator.AtMost(50),
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type:    "integer",
+				Minimum: "1",
+				Maximum: "10",
+			},
+		},
+		"int64-one-of": {
+			attribute: schema.Int64Attribute{
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.OneOf(80, 443)},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type: "integer",
+				Enum: []any{json.Number("80"), json.Number("443")},
+			},
+		},
+		"string-length": {
+			attribute: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 10),
+					stringvalidator.UTF8LengthAtMost(5),
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type:      "string",
+				MinLength: pointer(int64(1)),
+				MaxLength: pointer(int64(5)),
+			},
+		},
+		"string-one-of-none-of": {
+			attribute: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("a", "b", "c"),
+					stringvalidator.OneOf("a", "b"),
+					stringvalidator.NoneOf("b"),
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type: "string",
+				Enum: []any{"a", "b", "c"},
+				Not:  &validatorjsonschema.Schema{Enum: []any{"b"}},
+				AllOf: []*validatorjsonschema.Schema{
+					{Enum: []any{"a", "b"}},
+				},
+			},
+		},
+		"string-one-of-case-insensitive": {
+			attribute: schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOfCaseInsensitive("a")},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type: "string",
+				Extensions: []validatorjsonschema.Extension{
+					{
+						Kind:            "one_of",
+						Description:     `value must be one of: ["a"]`,
+						Values:          []any{"a"},
+						CaseInsensitive: true,
+					},
+				},
+			},
+		},
+		"string-regex": {
+			attribute: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^.{2}`), ""),
+					stringvalidator.IsRegex(stringvalidator.RegexOptions{}),
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type:    "string",
+				Pattern: `^[a-z]+$`,
+				Format:  "regex",
+				AllOf: []*validatorjsonschema.Schema{
+					{Pattern: `^.{2}`},
+				},
+			},
+		},
+		"string-json-matches-schema": {
+			attribute: schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.JSONMatchesSchema(`{"type": "object"}`)},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type:             "string",
+				ContentMediaType: "application/json",
+				ContentSchema:    json.RawMessage(`{"type": "object"}`),
+			},
+		},
+		"string-all-any": {
+			attribute: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.All(
+						stringvalidator.LengthAtLeast(1),
+						stringvalidator.Any(
+							stringvalidator.OneOf("default"),
+							stringvalidator.LengthAtLeast(5),
+						),
+					),
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type:      "string",
+				MinLength: pointer(int64(1)),
+				AnyOf: []*validatorjsonschema.Schema{
+					{Enum: []any{"default"}},
+					{MinLength: pointer(int64(5))},
+				},
+			},
+		},
+		"string-any-unmappable": {
+			attribute: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf("default"),
+						stringvalidator.IsTimeZone(),
+					),
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type: "string",
+				Extensions: []validatorjsonschema.Extension{
+					{
+						Kind:        "any",
+						Description: `Value must satisfy at least one of the validations: value must be one of: ["default"] + value must be an IANA Time Zone database name, such as "America/New_York" or "UTC"`,
+						Children: []validatorjsonschema.Extension{
+							{
+								Kind:        "one_of",
+								Description: `value must be one of: ["default"]`,
+								Values:      []any{"default"},
+							},
+							{
+								Kind:        "format",
+								Description: `value must be an IANA Time Zone database name, such as "America/New_York" or "UTC"`,
+								Format:      "time_zone",
+							},
+						},
+					},
+				},
+			},
+		},
+		"string-as-warning": {
+			attribute: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AsWarning(stringvalidator.LengthAtMost(5)),
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type: "string",
+				Extensions: []validatorjsonschema.Extension{
+					{
+						Kind:        "as_warning",
+						Description: "string length must be at most 5",
+						Children: []validatorjsonschema.Extension{
+							{
+								Kind:        "length",
+								Description: "string length must be at most 5",
+								Maximum:     "5",
+							},
+						},
+					},
+				},
+			},
+		},
+		"list-size-unique-elements": {
+			attribute: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(2)),
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type: "array",
+				Items: &validatorjsonschema.Schema{
+					Type:      "string",
+					MinLength: pointer(int64(2)),
+				},
+				MinItems:    pointer(int64(1)),
+				MaxItems:    pointer(int64(3)),
+				UniqueItems: true,
+			},
+		},
+		"map-size-keys-elements": {
+			attribute: schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+$`), "")),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf("x")),
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Type: "object",
+				AdditionalProperties: &validatorjsonschema.Schema{
+					Type: "string",
+					Enum: []any{"x"},
+				},
+				PropertyNames: &validatorjsonschema.Schema{
+					Pattern: `^[a-z]+$`,
+				},
+				MinProperties: pointer(int64(1)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": testCase.attribute,
+				},
+			}
+
+			got := validatorjsonschema.FromResourceSchema(context.Background(), s).Properties["test"]
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromResourceSchemaPathConstraints(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.String
+		expected   *validatorjsonschema.Schema
+	}{
+		"also-requires": {
+			validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("other")),
+			},
+			expected: &validatorjsonschema.Schema{
+				DependentRequired: map[string][]string{
+					"test": {"other"},
+				},
+			},
+		},
+		"at-least-one-of": {
+			validators: []validator.String{
+				stringvalidator.AtLeastOneOf(path.MatchRoot("other")),
+			},
+			expected: &validatorjsonschema.Schema{
+				AllOf: []*validatorjsonschema.Schema{
+					{
+						AnyOf: []*validatorjsonschema.Schema{
+							{Required: []string{"other"}},
+							{Required: []string{"test"}},
+						},
+					},
+				},
+			},
+		},
+		"conflicts-with": {
+			validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("other")),
+			},
+			expected: &validatorjsonschema.Schema{
+				AllOf: []*validatorjsonschema.Schema{
+					{
+						Not: &validatorjsonschema.Schema{Required: []string{"other", "test"}},
+					},
+				},
+			},
+		},
+		"exactly-one-of-duplicate": {
+			validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("other")),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("test"), path.MatchRoot("other")),
+			},
+			expected: &validatorjsonschema.Schema{
+				AllOf: []*validatorjsonschema.Schema{
+					{
+						OneOf: []*validatorjsonschema.Schema{
+							{Required: []string{"other"}},
+							{Required: []string{"test"}},
+						},
+					},
+				},
+			},
+		},
+		"exactly-one-of-other-object": {
+			validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("block").AtAnyListIndex().AtName("other")),
+			},
+			expected: &validatorjsonschema.Schema{
+				Extensions: []validatorjsonschema.Extension{
+					{
+						Kind:        "exactly_one_of",
+						Description: `Ensure that one and only one attribute from this collection is set: "[block[*].other]"`,
+						Paths:       []string{"block[*].other"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"other": schema.StringAttribute{
+						Optional: true,
+					},
+					"test": schema.StringAttribute{
+						Optional:   true,
+						Validators: testCase.validators,
+					},
+				},
+			}
+
+			document := validatorjsonschema.FromResourceSchema(context.Background(), s)
+
+			// Only the keywords of the object and the extensions of the
+			// attribute are compared.
+			got := &validatorjsonschema.Schema{
+				AllOf:             document.AllOf,
+				DependentRequired: document.DependentRequired,
+				Extensions:        document.Properties["test"].Extensions,
+			}
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+		})
+	}
+}
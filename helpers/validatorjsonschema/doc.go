@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validatorjsonschema exports provider, resource, and data source
+// schemas as JSON Schema draft 2020-12 documents, such as for tools which
+// render forms for configurations.
+//
+// Each attribute and block is a property of the object of its schema or
+// nested object, with the type, description, and deprecation of the
+// attribute or block. Required attributes are listed in the required
+// keyword of the object, while computed-only and write-only attributes are
+// annotated with the readOnly and writeOnly keywords. Configured attributes
+// are expected to be present, while null attributes are expected to be
+// absent.
+//
+// The constraints of validators, as described by the validatorspec package,
+// are mapped onto JSON Schema keywords:
+//   - length and UTF-8 length validators, such as
+//     stringvalidator.LengthBetween, to minLength and maxLength
+//   - range validators, such as int64validator.Between, to minimum and
+//     maximum
+//   - Equals to const
+//   - OneOf to enum, and NoneOf to not enum
+//   - RegexMatches to pattern
+//   - size validators, such as listvalidator.SizeBetween, to minItems and
+//     maxItems, or minProperties and maxProperties for maps
+//   - UniqueValues to uniqueItems
+//   - IsRegex to the regex format
+//   - JSONMatchesSchema to contentMediaType and contentSchema
+//   - element and key validators, such as listvalidator.ValueStringsAre, to
+//     the items, additionalProperties, or propertyNames schemas
+//   - All and Sequence to the keywords of each validator, and Any to anyOf
+//   - ExactlyOneOf to oneOf, and AtLeastOneOf to anyOf, of the required
+//     attributes in the object
+//   - AlsoRequires to dependentRequired, ConflictsWith and Conflicting to
+//     not required, and RequiredTogether to anyOf all or none required, in
+//     the object
+//
+// Path validators are only mapped when all of their paths resolve to
+// attributes in the same object, such as the attributes of the same nested
+// block. Regular expressions use the RE2 syntax of Go rather than the
+// ECMA-262 syntax of JSON Schema, which are largely compatible.
+//
+// Validators which cannot be mapped, such as format validators other than
+// IsRegex, case-insensitive OneOf, validators returning warnings, or
+// provider-defined validators, are listed as Extension objects under the
+// ExtensionKeyword of the schema of the attribute, block, or object. JSON
+// Schema validators ignore unknown keywords, so documents remain valid.
+package validatorjsonschema
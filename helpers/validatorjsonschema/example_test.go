@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorjsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorjsonschema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func ExampleFromResourceSchema() {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+					int64validator.ExactlyOneOf(path.MatchRoot("port_range")),
+				},
+			},
+			"port_range": schema.StringAttribute{
+				Optional: true,
+			},
+			"protocol": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tcp", "udp"),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.IsCronExpression(),
+				},
+			},
+		},
+	}
+
+	document := validatorjsonschema.FromResourceSchema(context.Background(), s)
+
+	output, err := json.MarshalIndent(document, "", "  ")
+
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(output))
+
+	// Output:
+	// {
+	//   "$schema": "https://json-schema.org/draft/2020-12/schema",
+	//   "type": "object",
+	//   "allOf": [
+	//     {
+	//       "oneOf": [
+	//         {
+	//           "required": [
+	//             "port"
+	//           ]
+	//         },
+	//         {
+	//           "required": [
+	//             "port_range"
+	//           ]
+	//         }
+	//       ]
+	//     }
+	//   ],
+	//   "properties": {
+	//     "port": {
+	//       "type": "integer",
+	//       "minimum": 1,
+	//       "maximum": 65535
+	//     },
+	//     "port_range": {
+	//       "type": "string"
+	//     },
+	//     "protocol": {
+	//       "type": "string",
+	//       "enum": [
+	//         "tcp",
+	//         "udp"
+	//       ]
+	//     },
+	//     "schedule": {
+	//       "type": "string",
+	//       "x-terraform-validators": [
+	//         {
+	//           "kind": "format",
+	//           "description": "value must be a cron expression with 5 fields: minute hour day-of-month month day-of-week",
+	//           "format": "cron_expression"
+	//         }
+	//       ]
+	//     }
+	//   },
+	//   "required": [
+	//     "protocol"
+	//   ]
+	// }
+}
@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorjsonschema
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/schemareflect"
+)
+
+// FromResourceSchema returns the JSON Schema document of the resource
+// schema, including the constraints of the resource configuration
+// validators.
+func FromResourceSchema(ctx context.Context, schema resourceschema.Schema, configValidators ...resource.ConfigValidator) *Schema {
+	return document(ctx, schema, validatorspec.OfAll(ctx, configValidators))
+}
+
+// FromDataSourceSchema returns the JSON Schema document of the data source
+// schema, including the constraints of the data source configuration
+// validators.
+func FromDataSourceSchema(ctx context.Context, schema datasourceschema.Schema, configValidators ...datasource.ConfigValidator) *Schema {
+	return document(ctx, schema, validatorspec.OfAll(ctx, configValidators))
+}
+
+// FromProviderSchema returns the JSON Schema document of the provider
+// schema, including the constraints of the provider configuration
+// validators.
+func FromProviderSchema(ctx context.Context, schema providerschema.Schema, configValidators ...provider.ConfigValidator) *Schema {
+	return document(ctx, schema, validatorspec.OfAll(ctx, configValidators))
+}
+
+// document returns the JSON Schema document of the schema, with the
+// constraints of the configuration validators applied to its object.
+func document(ctx context.Context, schema any, configConstraints []validatorspec.Constraint) *Schema {
+	b := builder{ctx: ctx}
+
+	result := b.object(path.Expression{}, schema)
+	result.Dialect = Dialect
+
+	annotate(result, schema)
+
+	for _, constraint := range configConstraints {
+		b.applyOrExtend(result, nil, constraint, &scope{object: result})
+	}
+
+	return result
+}
+
+// builder builds the schemas of attributes, blocks, and nested objects.
+type builder struct {
+	ctx context.Context
+}
+
+// object returns the object schema of the attributes and blocks of a schema
+// or nested object.
+func (b builder) object(expression path.Expression, value any) *Schema {
+	attributes := schemareflect.Attributes(value)
+	blocks := schemareflect.Blocks(value)
+
+	result := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema, len(attributes)+len(blocks)),
+	}
+
+	names := make([]string, 0, len(attributes)+len(blocks))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	for name := range blocks {
+		names = append(names, name)
+	}
+
+	// Properties are built in order of name, so the keywords of path
+	// validators are always added to the object in the same order.
+	slices.Sort(names)
+
+	for _, name := range names {
+		child, ok := attributes[name]
+
+		if !ok {
+			child = blocks[name]
+		}
+
+		childExpression := path.MatchRoot(name)
+
+		if len(expression.Steps()) > 0 {
+			childExpression = expression.AtName(name)
+		}
+
+		b.property(result, childExpression, name, child)
+	}
+
+	return result
+}
+
+// property adds the schema of the attribute or block to the object.
+func (b builder) property(object *Schema, expression path.Expression, name string, value any) {
+	typ := valueType(value)
+
+	var result *Schema
+
+	if nestedObject, ok := schemareflect.NestedObject(value); ok {
+		result = b.nested(expression, typ, nestedObject)
+	} else {
+		result = b.typeSchema(typ)
+	}
+
+	annotate(result, value)
+
+	if attribute, ok := value.(interface {
+		IsComputed() bool
+		IsOptional() bool
+		IsRequired() bool
+	}); ok {
+		if attribute.IsRequired() {
+			object.Required = append(object.Required, name)
+		}
+
+		result.ReadOnly = attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired()
+	}
+
+	if attribute, ok := value.(interface{ IsWriteOnly() bool }); ok {
+		result.WriteOnly = attribute.IsWriteOnly()
+	}
+
+	s := &scope{
+		object:     object,
+		expression: expression,
+		name:       name,
+	}
+
+	for _, constraint := range validatorspec.OfAll(b.ctx, schemareflect.Validators(value)) {
+		b.applyOrExtend(result, typ, constraint, s)
+	}
+
+	object.Properties[name] = result
+}
+
+// nested returns the schema of a nested attribute or block of the type with
+// the nested object.
+func (b builder) nested(expression path.Expression, typ attr.Type, nestedObject any) *Schema {
+	var elementExpression path.Expression
+
+	switch {
+	case isArray(b.ctx, typ) && isSet(b.ctx, typ):
+		elementExpression = expression.AtAnySetValue()
+	case isArray(b.ctx, typ):
+		elementExpression = expression.AtAnyListIndex()
+	case isMap(b.ctx, typ):
+		elementExpression = expression.AtAnyMapKey()
+	default:
+		// The nested objects of single nested attributes and blocks return
+		// the same validators as the attribute or block, which are applied
+		// to the same schema.
+		return b.object(expression, nestedObject)
+	}
+
+	object := b.object(elementExpression, nestedObject)
+
+	for _, constraint := range validatorspec.OfAll(b.ctx, schemareflect.Validators(nestedObject)) {
+		b.applyOrExtend(object, nil, constraint, nil)
+	}
+
+	if isMap(b.ctx, typ) {
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: object,
+		}
+	}
+
+	return &Schema{
+		Type:        "array",
+		Items:       object,
+		UniqueItems: isSet(b.ctx, typ),
+	}
+}
+
+// typeSchema returns the schema of values of the type.
+func (b builder) typeSchema(typ attr.Type) *Schema {
+	switch typ.(type) {
+	case nil:
+		return &Schema{}
+	case basetypes.Int32Typable, basetypes.Int64Typable:
+		return &Schema{Type: "integer"}
+	}
+
+	tfType := typ.TerraformType(b.ctx)
+
+	switch {
+	case tfType.Is(tftypes.String):
+		return &Schema{Type: "string"}
+	case tfType.Is(tftypes.Number):
+		return &Schema{Type: "number"}
+	case tfType.Is(tftypes.Bool):
+		return &Schema{Type: "boolean"}
+	case tfType.Is(tftypes.List{}), tfType.Is(tftypes.Set{}):
+		return &Schema{
+			Type:        "array",
+			Items:       b.typeSchema(elementType(typ)),
+			UniqueItems: tfType.Is(tftypes.Set{}),
+		}
+	case tfType.Is(tftypes.Map{}):
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: b.typeSchema(elementType(typ)),
+		}
+	case tfType.Is(tftypes.Tuple{}):
+		return &Schema{Type: "array"}
+	case tfType.Is(tftypes.Object{}):
+		result := &Schema{Type: "object"}
+
+		if objectType, ok := typ.(interface{ AttributeTypes() map[string]attr.Type }); ok {
+			result.Properties = make(map[string]*Schema, len(objectType.AttributeTypes()))
+
+			for name, attributeType := range objectType.AttributeTypes() {
+				result.Properties[name] = b.typeSchema(attributeType)
+			}
+		}
+
+		return result
+	default:
+		// Dynamic values can be of any type.
+		return &Schema{}
+	}
+}
+
+// annotate sets the description and deprecation of the schema from the
+// schema, attribute, or block.
+func annotate(result *Schema, value any) {
+	if described, ok := value.(interface {
+		GetDescription() string
+		GetMarkdownDescription() string
+	}); ok {
+		result.Description = described.GetDescription()
+
+		if result.Description == "" {
+			result.Description = described.GetMarkdownDescription()
+		}
+	}
+
+	if deprecated, ok := value.(interface{ GetDeprecationMessage() string }); ok {
+		result.Deprecated = deprecated.GetDeprecationMessage() != ""
+	}
+}
+
+// valueType returns the type of the attribute or block, whose type methods
+// are differently named.
+func valueType(value any) attr.Type {
+	switch v := value.(type) {
+	case interface{ GetType() attr.Type }:
+		return v.GetType()
+	case interface{ Type() attr.Type }:
+		return v.Type()
+	default:
+		return nil
+	}
+}
+
+// elementType returns the element type of the list, map, or set type, if
+// any.
+func elementType(typ attr.Type) attr.Type {
+	if collectionType, ok := typ.(interface{ ElementType() attr.Type }); ok {
+		return collectionType.ElementType()
+	}
+
+	return nil
+}
+
+// isArray returns true if the type is a list or set type.
+func isArray(ctx context.Context, typ attr.Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	tfType := typ.TerraformType(ctx)
+
+	return tfType.Is(tftypes.List{}) || tfType.Is(tftypes.Set{})
+}
+
+// isSet returns true if the type is a set type.
+func isSet(ctx context.Context, typ attr.Type) bool {
+	return typ != nil && typ.TerraformType(ctx).Is(tftypes.Set{})
+}
+
+// isMap returns true if the type is a map type.
+func isMap(ctx context.Context, typ attr.Type) bool {
+	return typ != nil && typ.TerraformType(ctx).Is(tftypes.Map{})
+}
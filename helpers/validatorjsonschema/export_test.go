@@ -0,0 +1,512 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorjsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorjsonschema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestFromResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema           schema.Schema
+		configValidators []resource.ConfigValidator
+		expected         *validatorjsonschema.Schema
+	}{
+		"empty": {
+			schema: schema.Schema{},
+			expected: &validatorjsonschema.Schema{
+				Dialect:    validatorjsonschema.Dialect,
+				Type:       "object",
+				Properties: map[string]*validatorjsonschema.Schema{},
+			},
+		},
+		"annotations": {
+			schema: schema.Schema{
+				Description: "Manages a widget.",
+				Attributes: map[string]schema.Attribute{
+					"computed": schema.StringAttribute{
+						Computed: true,
+					},
+					"deprecated": schema.StringAttribute{
+						Optional:           true,
+						DeprecationMessage: "Use required instead.",
+					},
+					"markdown": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The `markdown` attribute.",
+					},
+					"optional_computed": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+					"required": schema.StringAttribute{
+						Required:    true,
+						Description: "The required attribute.",
+					},
+					"write_only": schema.StringAttribute{
+						Optional:  true,
+						WriteOnly: true,
+					},
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Dialect:     validatorjsonschema.Dialect,
+				Type:        "object",
+				Description: "Manages a widget.",
+				Properties: map[string]*validatorjsonschema.Schema{
+					"computed": {
+						Type:     "string",
+						ReadOnly: true,
+					},
+					"deprecated": {
+						Type:       "string",
+						Deprecated: true,
+					},
+					"markdown": {
+						Type:        "string",
+						Description: "The `markdown` attribute.",
+					},
+					"optional_computed": {
+						Type: "string",
+					},
+					"required": {
+						Type:        "string",
+						Description: "The required attribute.",
+					},
+					"write_only": {
+						Type:      "string",
+						WriteOnly: true,
+					},
+				},
+				Required: []string{"required"},
+			},
+		},
+		"types": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"bool":    schema.BoolAttribute{Optional: true},
+					"dynamic": schema.DynamicAttribute{Optional: true},
+					"float32": schema.Float32Attribute{Optional: true},
+					"float64": schema.Float64Attribute{Optional: true},
+					"int32":   schema.Int32Attribute{Optional: true},
+					"int64":   schema.Int64Attribute{Optional: true},
+					"list": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"map": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.Int64Type,
+					},
+					"number": schema.NumberAttribute{Optional: true},
+					"object": schema.ObjectAttribute{
+						Optional: true,
+						AttributeTypes: map[string]attr.Type{
+							"nested": types.BoolType,
+						},
+					},
+					"set": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.ListType{ElemType: types.StringType},
+					},
+					"string": schema.StringAttribute{Optional: true},
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Dialect: validatorjsonschema.Dialect,
+				Type:    "object",
+				Properties: map[string]*validatorjsonschema.Schema{
+					"bool":    {Type: "boolean"},
+					"dynamic": {},
+					"float32": {Type: "number"},
+					"float64": {Type: "number"},
+					"int32":   {Type: "integer"},
+					"int64":   {Type: "integer"},
+					"list": {
+						Type:  "array",
+						Items: &validatorjsonschema.Schema{Type: "string"},
+					},
+					"map": {
+						Type:                 "object",
+						AdditionalProperties: &validatorjsonschema.Schema{Type: "integer"},
+					},
+					"number": {Type: "number"},
+					"object": {
+						Type: "object",
+						Properties: map[string]*validatorjsonschema.Schema{
+							"nested": {Type: "boolean"},
+						},
+					},
+					"set": {
+						Type: "array",
+						Items: &validatorjsonschema.Schema{
+							Type:  "array",
+							Items: &validatorjsonschema.Schema{Type: "string"},
+						},
+						UniqueItems: true,
+					},
+					"string": {Type: "string"},
+				},
+			},
+		},
+		"nested-attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{Required: true},
+							},
+							Validators: []validator.Object{
+								objectvalidator.AlsoRequires(path.MatchRelative().AtName("name")),
+							},
+						},
+					},
+					"map": schema.MapNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+					"set": schema.SetNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+					"single": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{Required: true},
+						},
+					},
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Dialect: validatorjsonschema.Dialect,
+				Type:    "object",
+				Properties: map[string]*validatorjsonschema.Schema{
+					"list": {
+						Type: "array",
+						Items: &validatorjsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*validatorjsonschema.Schema{
+								"name": {Type: "string"},
+							},
+							Required: []string{"name"},
+							Extensions: []validatorjsonschema.Extension{
+								{
+									Kind:        "also_requires",
+									Description: `Ensure that if an attribute is set, also these are set: "[name]"`,
+									Paths:       []string{"name"},
+								},
+							},
+						},
+					},
+					"map": {
+						Type: "object",
+						AdditionalProperties: &validatorjsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*validatorjsonschema.Schema{
+								"name": {Type: "string"},
+							},
+						},
+					},
+					"set": {
+						Type: "array",
+						Items: &validatorjsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*validatorjsonschema.Schema{
+								"name": {Type: "string"},
+							},
+						},
+						UniqueItems: true,
+					},
+					"single": {
+						Type: "object",
+						Properties: map[string]*validatorjsonschema.Schema{
+							"name": {Type: "string"},
+						},
+						Required: []string{"name"},
+					},
+				},
+			},
+		},
+		"blocks": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"list": schema.ListNestedBlock{
+						Validators: []validator.List{
+							listvalidator.IsRequired(),
+							listvalidator.SizeAtMost(2),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+					"set": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Blocks: map[string]schema.Block{
+								"nested": schema.SingleNestedBlock{
+									Description: "The nested block.",
+									Attributes: map[string]schema.Attribute{
+										"name": schema.StringAttribute{Optional: true},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &validatorjsonschema.Schema{
+				Dialect: validatorjsonschema.Dialect,
+				Type:    "object",
+				Properties: map[string]*validatorjsonschema.Schema{
+					"list": {
+						Type: "array",
+						Items: &validatorjsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*validatorjsonschema.Schema{
+								"name": {Type: "string"},
+							},
+						},
+						MaxItems: pointer(int64(2)),
+					},
+					"set": {
+						Type: "array",
+						Items: &validatorjsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*validatorjsonschema.Schema{
+								"nested": {
+									Type:        "object",
+									Description: "The nested block.",
+									Properties: map[string]*validatorjsonschema.Schema{
+										"name": {Type: "string"},
+									},
+								},
+							},
+						},
+						UniqueItems: true,
+					},
+				},
+				Required: []string{"list"},
+			},
+		},
+		"config-validators": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"a": schema.StringAttribute{Optional: true},
+					"b": schema.StringAttribute{Optional: true},
+					"c": schema.StringAttribute{Optional: true},
+				},
+			},
+			configValidators: []resource.ConfigValidator{
+				resourcevalidator.ExactlyOneOf(path.MatchRoot("a"), path.MatchRoot("b")),
+				resourcevalidator.Conflicting(path.MatchRoot("b"), path.MatchRoot("c")),
+				resourcevalidator.PreferWriteOnlyAttribute(path.MatchRoot("a"), path.MatchRoot("c")),
+			},
+			expected: &validatorjsonschema.Schema{
+				Dialect: validatorjsonschema.Dialect,
+				Type:    "object",
+				AllOf: []*validatorjsonschema.Schema{
+					{
+						OneOf: []*validatorjsonschema.Schema{
+							{Required: []string{"a"}},
+							{Required: []string{"b"}},
+						},
+					},
+					{
+						Not: &validatorjsonschema.Schema{Required: []string{"b", "c"}},
+					},
+				},
+				Properties: map[string]*validatorjsonschema.Schema{
+					"a": {Type: "string"},
+					"b": {Type: "string"},
+					"c": {Type: "string"},
+				},
+				Extensions: []validatorjsonschema.Extension{
+					{
+						Kind:        "prefer_write_only_attribute",
+						Description: `The write-only attribute c should be preferred over the regular attribute a`,
+						Paths:       []string{"a", "c"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorjsonschema.FromResourceSchema(context.Background(), testCase.schema, testCase.configValidators...)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	s := datasourceschema.Schema{
+		Attributes: map[string]datasourceschema.Attribute{
+			"id": datasourceschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": datasourceschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	expected := &validatorjsonschema.Schema{
+		Dialect: validatorjsonschema.Dialect,
+		Type:    "object",
+		AllOf: []*validatorjsonschema.Schema{
+			{
+				AnyOf: []*validatorjsonschema.Schema{
+					{Required: []string{"id"}},
+					{Required: []string{"name"}},
+				},
+			},
+		},
+		Properties: map[string]*validatorjsonschema.Schema{
+			"id": {
+				Type:      "string",
+				MinLength: pointer(int64(1)),
+			},
+			"name": {Type: "string"},
+		},
+	}
+
+	got := validatorjsonschema.FromDataSourceSchema(
+		context.Background(),
+		s,
+		[]datasource.ConfigValidator{
+			datasourcevalidator.AtLeastOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		}...,
+	)
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected schema difference: %s", diff)
+	}
+}
+
+func TestFromProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	s := providerschema.Schema{
+		Attributes: map[string]providerschema.Attribute{
+			"password": providerschema.StringAttribute{
+				Optional: true,
+			},
+			"username": providerschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	expected := &validatorjsonschema.Schema{
+		Dialect: validatorjsonschema.Dialect,
+		Type:    "object",
+		AllOf: []*validatorjsonschema.Schema{
+			{
+				AnyOf: []*validatorjsonschema.Schema{
+					{Required: []string{"password", "username"}},
+					{
+						Not: &validatorjsonschema.Schema{
+							AnyOf: []*validatorjsonschema.Schema{
+								{Required: []string{"password"}},
+								{Required: []string{"username"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Properties: map[string]*validatorjsonschema.Schema{
+			"password": {Type: "string"},
+			"username": {Type: "string"},
+		},
+	}
+
+	got := validatorjsonschema.FromProviderSchema(
+		context.Background(),
+		s,
+		[]provider.ConfigValidator{
+			providervalidator.RequiredTogether(path.MatchRoot("username"), path.MatchRoot("password")),
+		}...,
+	)
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected schema difference: %s", diff)
+	}
+}
+
+func TestExtensionKeyword(t *testing.T) {
+	t.Parallel()
+
+	s := &validatorjsonschema.Schema{
+		Extensions: []validatorjsonschema.Extension{
+			{Kind: "custom"},
+		},
+	}
+
+	output, err := json.Marshal(s)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got map[string]any
+
+	if err := json.Unmarshal(output, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := got[validatorjsonschema.ExtensionKeyword]; !ok {
+		t.Errorf("expected %s keyword, got: %s", validatorjsonschema.ExtensionKeyword, output)
+	}
+}
+
+func pointer[T any](value T) *T {
+	return &value
+}
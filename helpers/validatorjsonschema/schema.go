@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorjsonschema
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// Dialect is the $schema of exported documents.
+const Dialect = "https://json-schema.org/draft/2020-12/schema"
+
+// ExtensionKeyword is the keyword of the validators of a schema which cannot
+// be mapped onto JSON Schema keywords. Its value is an array of Extension
+// objects.
+const ExtensionKeyword = "x-terraform-validators"
+
+// Schema is a JSON Schema document or subschema. Empty fields are omitted
+// when marshaled.
+type Schema struct {
+	Dialect     string `json:"$schema,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Deprecated  bool   `json:"deprecated,omitempty"`
+	ReadOnly    bool   `json:"readOnly,omitempty"`
+	WriteOnly   bool   `json:"writeOnly,omitempty"`
+
+	Const  any       `json:"const,omitempty"`
+	Enum   []any     `json:"enum,omitempty"`
+	Format string    `json:"format,omitempty"`
+	Not    *Schema   `json:"not,omitempty"`
+	AllOf  []*Schema `json:"allOf,omitempty"`
+	AnyOf  []*Schema `json:"anyOf,omitempty"`
+	OneOf  []*Schema `json:"oneOf,omitempty"`
+
+	Minimum json.Number `json:"minimum,omitempty"`
+	Maximum json.Number `json:"maximum,omitempty"`
+
+	MinLength        *int64          `json:"minLength,omitempty"`
+	MaxLength        *int64          `json:"maxLength,omitempty"`
+	Pattern          string          `json:"pattern,omitempty"`
+	ContentMediaType string          `json:"contentMediaType,omitempty"`
+	ContentSchema    json.RawMessage `json:"contentSchema,omitempty"`
+
+	Items       *Schema `json:"items,omitempty"`
+	MinItems    *int64  `json:"minItems,omitempty"`
+	MaxItems    *int64  `json:"maxItems,omitempty"`
+	UniqueItems bool    `json:"uniqueItems,omitempty"`
+
+	Properties           map[string]*Schema  `json:"properties,omitempty"`
+	Required             []string            `json:"required,omitempty"`
+	DependentRequired    map[string][]string `json:"dependentRequired,omitempty"`
+	AdditionalProperties *Schema             `json:"additionalProperties,omitempty"`
+	PropertyNames        *Schema             `json:"propertyNames,omitempty"`
+	MinProperties        *int64              `json:"minProperties,omitempty"`
+	MaxProperties        *int64              `json:"maxProperties,omitempty"`
+
+	// Extensions are the validators which cannot be mapped onto JSON
+	// Schema keywords, under the ExtensionKeyword.
+	Extensions []Extension `json:"x-terraform-validators,omitempty"`
+}
+
+// Extension is a validator which cannot be mapped onto JSON Schema keywords.
+// The fields other than Kind and Description are those of the
+// validatorspec.Constraint of the validator.
+type Extension struct {
+	Kind            validatorspec.Kind   `json:"kind"`
+	Description     string               `json:"description,omitempty"`
+	Format          validatorspec.Format `json:"format,omitempty"`
+	Minimum         json.Number          `json:"minimum,omitempty"`
+	Maximum         json.Number          `json:"maximum,omitempty"`
+	Values          []any                `json:"values,omitempty"`
+	CaseInsensitive bool                 `json:"caseInsensitive,omitempty"`
+	Pattern         string               `json:"pattern,omitempty"`
+	Separator       string               `json:"separator,omitempty"`
+	Paths           []string             `json:"paths,omitempty"`
+	Children        []Extension          `json:"children,omitempty"`
+}
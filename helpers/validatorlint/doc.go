@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validatorlint statically checks the validators of provider,
+// resource, and data source schemas for misconfigurations which otherwise
+// only surface when configurations are validated, such as in a unit test
+// of the provider:
+//
+//	func TestExampleResourceSchema(t *testing.T) {
+//		ctx := context.Background()
+//		r := NewExampleResource()
+//		schemaResponse := &resource.SchemaResponse{}
+//
+//		r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+//
+//		diags := validatorlint.CheckResourceSchema(ctx, schemaResponse.Schema, r.ConfigValidators(ctx)...)
+//
+//		validatortest.ExpectNoDiagnostics(t, diags)
+//	}
+//
+// The constraints of validators, as described by the validatorspec package,
+// are checked for:
+//   - bounds where the minimum is greater than the maximum, such as
+//     float64validator.Between(10, 1), or negative lengths and sizes
+//   - OneOf without values, which no value satisfies
+//   - path expressions which do not resolve to an attribute or block of the
+//     schema, such as a misspelled ConflictsWith attribute
+//   - path expressions which only reference the attribute of the validator
+//     itself, which validators skip
+//   - ExactlyOneOf of required attributes, which are always configured
+//   - sum and product validators, such as int64validator.AtLeastSumOf,
+//     referencing attributes which are not integers
+//
+// Provider-defined validators which do not describe a constraint are not
+// checked, beyond the validators they are combined with.
+package validatorlint
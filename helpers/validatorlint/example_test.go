@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorlint_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorlint"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func ExampleCheckResourceSchema() {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(65535, 1),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("port_range")),
+				},
+			},
+		},
+	}
+
+	diags := validatorlint.CheckResourceSchema(context.Background(), s)
+
+	for _, d := range diags {
+		fmt.Println(d.Detail())
+	}
+
+	// Output:
+	// Attribute port validator "value must be between 65535 and 1" has a minimum of 65535, which is greater than its maximum of 1.
+	// Attribute protocol validator "Ensure that if an attribute is set, these are not set: \"[port_range]\"" references port_range, which does not match an attribute or block of the schema.
+}
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorlint
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// CheckResourceSchema checks the validators of the resource schema and the
+// resource configuration validators, returning an error diagnostic for each
+// misconfiguration.
+func CheckResourceSchema(ctx context.Context, schema resourceschema.Schema, configValidators ...resource.ConfigValidator) diag.Diagnostics {
+	return check(ctx, schema, validatorspec.SchemaConstraints(ctx, schema), validatorspec.OfAll(ctx, configValidators))
+}
+
+// CheckDataSourceSchema checks the validators of the data source schema and
+// the data source configuration validators, returning an error diagnostic
+// for each misconfiguration.
+func CheckDataSourceSchema(ctx context.Context, schema datasourceschema.Schema, configValidators ...datasource.ConfigValidator) diag.Diagnostics {
+	return check(ctx, schema, validatorspec.SchemaConstraints(ctx, schema), validatorspec.OfAll(ctx, configValidators))
+}
+
+// CheckProviderSchema checks the validators of the provider schema and the
+// provider configuration validators, returning an error diagnostic for each
+// misconfiguration.
+func CheckProviderSchema(ctx context.Context, schema providerschema.Schema, configValidators ...provider.ConfigValidator) diag.Diagnostics {
+	return check(ctx, schema, validatorspec.SchemaConstraints(ctx, schema), validatorspec.OfAll(ctx, configValidators))
+}
+
+// check checks the constraints of the attributes and blocks of the schema,
+// followed by the constraints of the configuration validators.
+func check(ctx context.Context, schema interface{ Type() attr.Type }, attributeConstraints []validatorspec.AttributeConstraints, configConstraints []validatorspec.Constraint) diag.Diagnostics {
+	l := linter{
+		ctx:      ctx,
+		resolver: resolver{ctx: ctx, schema: schema},
+	}
+
+	for _, attributeConstraint := range attributeConstraints {
+		for _, constraint := range attributeConstraint.Constraints {
+			l.checkAttribute(attributeConstraint.Path, constraint)
+		}
+	}
+
+	for _, constraint := range configConstraints {
+		l.checkConfig(constraint)
+	}
+
+	return l.diags
+}
+
+// linter collects the diagnostics of misconfigured constraints.
+type linter struct {
+	ctx      context.Context
+	resolver resolver
+	diags    diag.Diagnostics
+}
+
+// checkAttribute checks the constraint of a validator of the attribute,
+// block, or nested object of the expression, and those of nested
+// validators.
+func (l *linter) checkAttribute(expression path.Expression, constraint validatorspec.Constraint) {
+	subject := fmt.Sprintf("Attribute %s", expression)
+
+	l.checkBounds(subject, constraint)
+
+	own, ownOk := l.resolver.resolve(expression)
+
+	for _, pathExpression := range constraint.Paths {
+		merged := expression.Merge(pathExpression)
+
+		if isSelfReference(expression, pathExpression) {
+			l.addError(subject, constraint, "only references the attribute it is applied to, which it skips")
+
+			continue
+		}
+
+		l.checkPath(subject, constraint, merged)
+	}
+
+	if constraint.Kind == validatorspec.KindExactlyOneOf && ownOk && own.isRequired() {
+		l.addError(subject, constraint, "is applied to a required attribute, which is always configured, so the other attributes can never be configured")
+	}
+
+	for _, child := range constraint.Children {
+		childExpression := expression
+
+		if ownOk && (constraint.Kind == validatorspec.KindElements || constraint.Kind == validatorspec.KindKeys) {
+			childExpression = own.elementExpression(l.ctx, expression)
+		}
+
+		l.checkAttribute(childExpression, child)
+	}
+}
+
+// checkConfig checks the constraint of a configuration validator, and those
+// of nested validators.
+func (l *linter) checkConfig(constraint validatorspec.Constraint) {
+	subject := "Configuration"
+
+	l.checkBounds(subject, constraint)
+
+	for _, pathExpression := range constraint.Paths {
+		l.checkPath(subject, constraint, pathExpression)
+	}
+
+	for _, child := range constraint.Children {
+		l.checkConfig(child)
+	}
+}
+
+// checkBounds checks the minimum and maximum, and the values, of the
+// constraint.
+func (l *linter) checkBounds(subject string, constraint validatorspec.Constraint) {
+	if constraint.Min != nil && constraint.Max != nil && constraint.Min.Cmp(constraint.Max) > 0 {
+		l.addError(subject, constraint, fmt.Sprintf("has a minimum of %s, which is greater than its maximum of %s", number(constraint.Min), number(constraint.Max)))
+	}
+
+	if isCount(constraint.Kind) {
+		if constraint.Min != nil && constraint.Min.Sign() < 0 {
+			l.addError(subject, constraint, fmt.Sprintf("has a negative minimum of %s", number(constraint.Min)))
+		}
+
+		if constraint.Max != nil && constraint.Max.Sign() < 0 {
+			l.addError(subject, constraint, fmt.Sprintf("has a negative maximum of %s", number(constraint.Max)))
+		}
+	}
+
+	if constraint.Kind == validatorspec.KindOneOf && len(constraint.Values) == 0 {
+		l.addError(subject, constraint, "has no values, so no value is valid")
+	}
+}
+
+// checkPath checks a path expression of the constraint, after merging with
+// the expression of the attribute, if any.
+func (l *linter) checkPath(subject string, constraint validatorspec.Constraint, expression path.Expression) {
+	target, ok := l.resolver.resolve(expression)
+
+	if !ok {
+		l.addError(subject, constraint, fmt.Sprintf("references %s, which does not match an attribute or block of the schema", expression))
+
+		return
+	}
+
+	switch constraint.Kind {
+	case validatorspec.KindExactlyOneOf:
+		if target.isRequired() {
+			l.addError(subject, constraint, fmt.Sprintf("references the required attribute %s, which is always configured, so the other attributes can never be configured", expression))
+		}
+	case validatorspec.KindAtLeastSumOf, validatorspec.KindAtMostSumOf, validatorspec.KindEqualToSumOf, validatorspec.KindEqualToProductOf:
+		if !target.isDynamic(l.ctx) && !isInteger(l.ctx, target.typ) {
+			l.addError(subject, constraint, fmt.Sprintf("references %s, which is not an integer attribute", expression))
+		}
+	}
+}
+
+// addError adds an error diagnostic for the constraint.
+func (l *linter) addError(subject string, constraint validatorspec.Constraint, problem string) {
+	l.diags.AddError(
+		"Invalid Validator Usage",
+		fmt.Sprintf("%s validator %q %s.", subject, constraint.Description, problem),
+	)
+}
+
+// isSelfReference returns true if the path expression of a validator of the
+// attribute of the expression only matches the attribute itself. Root
+// expressions of attributes within collections also match the attribute of
+// other elements.
+func isSelfReference(expression path.Expression, pathExpression path.Expression) bool {
+	merged := expression.Merge(pathExpression)
+
+	if !merged.Resolve().Equal(expression.Resolve()) {
+		return false
+	}
+
+	// Merging a root expression returns it unchanged.
+	relative := len(merged.Steps()) != len(pathExpression.Steps())
+
+	return relative || !hasAnyStep(expression)
+}
+
+// hasAnyStep returns true if the expression matches any list index, map
+// key, or set value.
+func hasAnyStep(expression path.Expression) bool {
+	for _, step := range expression.Steps() {
+		switch step.(type) {
+		case path.ExpressionStepElementKeyIntAny, path.ExpressionStepElementKeyStringAny, path.ExpressionStepElementKeyValueAny:
+			return true
+		}
+	}
+
+	return false
+}
+
+// isCount returns true if the bounds of the constraint kind are lengths,
+// sizes, or counts, which cannot be negative.
+func isCount(kind validatorspec.Kind) bool {
+	switch kind {
+	case validatorspec.KindLength,
+		validatorspec.KindUTF8Length,
+		validatorspec.KindSize,
+		validatorspec.KindDelimitedSegments,
+		validatorspec.KindPasswordPolicy:
+		return true
+	default:
+		return false
+	}
+}
+
+// isInteger returns true if the values of the type can be converted to
+// 32-bit or 64-bit integers.
+func isInteger(ctx context.Context, typ attr.Type) bool {
+	switch typ.ValueType(ctx).(type) {
+	case basetypes.Int32Valuable, basetypes.Int64Valuable:
+		return true
+	default:
+		return false
+	}
+}
+
+// number formats the bound.
+func number(value *big.Float) string {
+	return value.Text('g', -1)
+}
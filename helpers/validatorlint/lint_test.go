@@ -0,0 +1,441 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorlint_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorlint"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestCheckResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema           schema.Schema
+		configValidators []resource.ConfigValidator
+		expected         diag.Diagnostics
+	}{
+		"valid": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"count": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 10),
+							int64validator.AtLeastSumOf(path.MatchRoot("minimum")),
+						},
+					},
+					"minimum": schema.Int64Attribute{
+						Optional: true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 64),
+							stringvalidator.OneOf("a", "b"),
+							stringvalidator.ConflictsWith(path.MatchRoot("rule").AtAnyListIndex().AtName("name")),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"rule": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("prefix")),
+									},
+								},
+								"prefix": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			configValidators: []resource.ConfigValidator{
+				resourcevalidator.Conflicting(path.MatchRoot("count"), path.MatchRoot("minimum")),
+			},
+		},
+		"bounds": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"ratio": schema.Float64Attribute{
+						Optional: true,
+						Validators: []validator.Float64{
+							float64validator.Between(10, 1),
+						},
+					},
+					"size": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.IsQuantity(stringvalidator.QuantityUnitSystemBytes, "2KiB", "1KiB"),
+						},
+					},
+					"tags": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(-1),
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute ratio validator "value must be between 10.000000 and 1.000000" has a minimum of 10, which is greater than its maximum of 1.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute size validator "value must be a byte size, such as \"10GiB\", between 2KiB and 1KiB" has a minimum of 2048, which is greater than its maximum of 1024.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute tags validator "list must contain at least -1 elements" has a negative minimum of -1.`,
+				),
+			},
+		},
+		"one-of-empty": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf()),
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute tags[*] validator "value must be one of: []" has no values, so no value is valid.`,
+				),
+			},
+		},
+		"path-unresolved": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("nmae"),
+								path.MatchRoot("tags").AtName("name"),
+								path.MatchRelative().AtParent().AtParent().AtName("name"),
+							),
+						},
+					},
+					"tags": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute name validator "Ensure that if an attribute is set, these are not set: \"[nmae,tags.name,<.<.name]\"" references nmae, which does not match an attribute or block of the schema.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute name validator "Ensure that if an attribute is set, these are not set: \"[nmae,tags.name,<.<.name]\"" references tags.name, which does not match an attribute or block of the schema.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute name validator "Ensure that if an attribute is set, these are not set: \"[nmae,tags.name,<.<.name]\"" references name.<.<.name, which does not match an attribute or block of the schema.`,
+				),
+			},
+		},
+		"path-nested": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"dynamic": schema.DynamicAttribute{
+						Optional: true,
+					},
+					"rule": schema.SetNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.AlsoRequires(
+											path.MatchRelative().AtParent().AtName("port"),
+											path.MatchRelative().AtParent().AtName("ports").AtAnyMapKey(),
+											path.MatchRoot("dynamic").AtName("anything").AtListIndex(0),
+										),
+										stringvalidator.ConflictsWith(
+											path.MatchRoot("rule").AtAnyListIndex().AtName("name"),
+										),
+									},
+								},
+								"ports": schema.MapAttribute{
+									ElementType: types.Int64Type,
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute rule[Value(*)].name validator "Ensure that if an attribute is set, also these are set: \"[<.port,<.ports[\\\"*\\\"],dynamic.anything[0]]\"" references rule[Value(*)].name.<.port, which does not match an attribute or block of the schema.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute rule[Value(*)].name validator "Ensure that if an attribute is set, these are not set: \"[rule[*].name]\"" references rule[*].name, which does not match an attribute or block of the schema.`,
+				),
+			},
+		},
+		"self-reference": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRoot("name")),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"rule": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.AlsoRequires(path.MatchRelative()),
+										// Other elements of the block.
+										stringvalidator.ConflictsWith(path.MatchRoot("rule").AtAnyListIndex().AtName("name")),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute name validator "Ensure that if an attribute is set, these are not set: \"[name]\"" only references the attribute it is applied to, which it skips.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute rule[*].name validator "Ensure that if an attribute is set, also these are set: \"[]\"" only references the attribute it is applied to, which it skips.`,
+				),
+			},
+		},
+		"exactly-one-of-required": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRoot("prefix")),
+						},
+					},
+					"prefix": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+						},
+					},
+				},
+			},
+			configValidators: []resource.ConfigValidator{
+				resourcevalidator.ExactlyOneOf(path.MatchRoot("name"), path.MatchRoot("prefix")),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute name validator "Ensure that one and only one attribute from this collection is set: \"[prefix]\"" is applied to a required attribute, which is always configured, so the other attributes can never be configured.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute prefix validator "Ensure that one and only one attribute from this collection is set: \"[name]\"" references the required attribute name, which is always configured, so the other attributes can never be configured.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Configuration validator "Exactly one of these attributes must be configured: [name,prefix]" references the required attribute name, which is always configured, so the other attributes can never be configured.`,
+				),
+			},
+		},
+		"sum-type-mismatch": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"count": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.EqualToSumOf(
+								path.MatchRoot("int32"),
+								path.MatchRoot("float"),
+								path.MatchRoot("name"),
+								path.MatchRoot("counts").AtAnyListIndex(),
+							),
+						},
+					},
+					"counts": schema.ListAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+					},
+					"float": schema.Float64Attribute{
+						Optional: true,
+					},
+					"int32": schema.Int32Attribute{
+						Optional: true,
+					},
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute count validator "value must be equal to the sum of int32 + float + name + counts[*]" references float, which is not an integer attribute.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute count validator "value must be equal to the sum of int32 + float + name + counts[*]" references name, which is not an integer attribute.`,
+				),
+			},
+		},
+		"combinators": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.Any(
+								stringvalidator.LengthBetween(2, 1),
+								stringvalidator.ConflictsWith(path.MatchRoot("missing")),
+							),
+						},
+					},
+				},
+			},
+			configValidators: []resource.ConfigValidator{
+				resourcevalidator.Any(
+					resourcevalidator.AtLeastOneOf(path.MatchRoot("name"), path.MatchRoot("missing")),
+				),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute name validator "string length must be between 2 and 1" has a minimum of 2, which is greater than its maximum of 1.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Attribute name validator "Ensure that if an attribute is set, these are not set: \"[missing]\"" references missing, which does not match an attribute or block of the schema.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Usage",
+					`Configuration validator "At least one of these attributes must be configured: [name,missing]" references missing, which does not match an attribute or block of the schema.`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorlint.CheckResourceSchema(context.Background(), testCase.schema, testCase.configValidators...)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	s := datasourceschema.Schema{
+		Attributes: map[string]datasourceschema.Attribute{
+			"id": datasourceschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	got := validatorlint.CheckDataSourceSchema(
+		context.Background(),
+		s,
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	)
+
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid Validator Usage",
+			`Configuration validator "Exactly one of these attributes must be configured: [id,name]" references name, which does not match an attribute or block of the schema.`,
+		),
+	}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestCheckProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	s := providerschema.Schema{
+		Attributes: map[string]providerschema.Attribute{
+			"endpoint": providerschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthBetween(10, 0),
+				},
+			},
+			"token": providerschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	got := validatorlint.CheckProviderSchema(
+		context.Background(),
+		s,
+		providervalidator.RequiredTogether(path.MatchRoot("endpoint"), path.MatchRoot("token")),
+	)
+
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid Validator Usage",
+			`Attribute endpoint validator "UTF-8 character count must be between 10 and 0" has a minimum of 10, which is greater than its maximum of 0.`,
+		),
+	}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
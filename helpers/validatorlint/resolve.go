@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorlint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/schemareflect"
+)
+
+// resolver resolves path expressions against a schema.
+type resolver struct {
+	ctx    context.Context
+	schema interface{ Type() attr.Type }
+}
+
+// target is the type, and the schema attribute, block, or nested object, if
+// any, matched by a path expression.
+type target struct {
+	typ attr.Type
+
+	// node is nil within the values of attributes which are not nested
+	// attributes, such as the elements of a list of strings.
+	node any
+}
+
+// resolve returns the target of the expression, or false if the expression
+// does not match any attribute or block of the schema. Expressions which
+// traverse into dynamic values are resolved at the dynamic value, as their
+// types are only known in configurations.
+func (r resolver) resolve(expression path.Expression) (target, bool) {
+	steps := expression.Resolve().Steps()
+
+	if len(steps) == 0 {
+		return target{}, false
+	}
+
+	current := target{
+		typ:  r.schema.Type(),
+		node: r.schema,
+	}
+
+	for _, step := range steps {
+		if current.isDynamic(r.ctx) {
+			return current, true
+		}
+
+		next, ok := current.step(r.ctx, step)
+
+		if !ok {
+			return target{}, false
+		}
+
+		current = next
+	}
+
+	return current, true
+}
+
+// step returns the target of the expression step within the target.
+func (t target) step(ctx context.Context, step path.ExpressionStep) (target, bool) {
+	switch step := step.(type) {
+	case path.ExpressionStepAttributeNameExact:
+		typ, ok := t.typ.(attr.TypeWithAttributeTypes)
+
+		if !ok {
+			return target{}, false
+		}
+
+		attributeType, ok := typ.AttributeTypes()[string(step)]
+
+		if !ok {
+			return target{}, false
+		}
+
+		return target{
+			typ:  attributeType,
+			node: childNode(t.node, string(step)),
+		}, true
+	case path.ExpressionStepElementKeyIntAny, path.ExpressionStepElementKeyIntExact:
+		return t.element(ctx, tftypes.List{})
+	case path.ExpressionStepElementKeyStringAny, path.ExpressionStepElementKeyStringExact:
+		return t.element(ctx, tftypes.Map{})
+	case path.ExpressionStepElementKeyValueAny, path.ExpressionStepElementKeyValueExact:
+		return t.element(ctx, tftypes.Set{})
+	default:
+		return target{}, false
+	}
+}
+
+// element returns the target of the elements of the target, if it is a
+// collection of the given type.
+func (t target) element(ctx context.Context, collection tftypes.Type) (target, bool) {
+	typ, ok := t.typ.(attr.TypeWithElementType)
+
+	if !ok || !t.typ.TerraformType(ctx).Is(collection) {
+		return target{}, false
+	}
+
+	result := target{
+		typ: typ.ElementType(),
+	}
+
+	if t.node != nil {
+		if nestedObject, ok := schemareflect.NestedObject(t.node); ok {
+			result.node = nestedObject
+		}
+	}
+
+	return result, true
+}
+
+// elementExpression returns the expression of the elements of the target
+// of the expression, such as for the validators of ValueStringsAre.
+func (t target) elementExpression(ctx context.Context, expression path.Expression) path.Expression {
+	tfType := t.typ.TerraformType(ctx)
+
+	switch {
+	case tfType.Is(tftypes.List{}):
+		return expression.AtAnyListIndex()
+	case tfType.Is(tftypes.Set{}):
+		return expression.AtAnySetValue()
+	case tfType.Is(tftypes.Map{}):
+		return expression.AtAnyMapKey()
+	default:
+		return expression
+	}
+}
+
+// isDynamic returns true if the type of the target is dynamic.
+func (t target) isDynamic(ctx context.Context) bool {
+	return t.typ.TerraformType(ctx).Is(tftypes.DynamicPseudoType)
+}
+
+// isRequired returns true if the target is a required attribute.
+func (t target) isRequired() bool {
+	attribute, ok := t.node.(interface{ IsRequired() bool })
+
+	return ok && attribute.IsRequired()
+}
+
+// childNode returns the attribute or block of the schema or nested object,
+// or of the nested object of a single nested attribute or block. It returns
+// nil within the values of attributes which are not nested attributes.
+func childNode(node any, name string) any {
+	if node == nil {
+		return nil
+	}
+
+	if nestedObject, ok := schemareflect.NestedObject(node); ok {
+		node = nestedObject
+	}
+
+	if attribute, ok := schemareflect.Attributes(node)[name]; ok {
+		return attribute
+	}
+
+	if block, ok := schemareflect.Blocks(node)[name]; ok {
+		return block
+	}
+
+	return nil
+}
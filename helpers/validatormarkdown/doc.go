@@ -0,0 +1,18 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validatormarkdown renders the Markdown descriptions of validators,
+// such as for documentation generated with tfplugindocs.
+//
+// The MarkdownDescription of every validator in this module is rendered
+// from its validatorspec.Constraint with Describe:
+//   - values and bounds are formatted as code, such as `"a"` or `10`
+//   - the values of OneOf and NoneOf are bullet lists
+//   - the validators of combinators, such as All and Any, and of element
+//     and key validators are nested bullet lists
+//   - path expressions are links to the anchors of the attributes, such as
+//     [`block.attribute`](#block.attribute)
+//
+// Section renders the validators of an attribute as a single Constraints
+// section, for the MarkdownDescription of the attribute.
+package validatormarkdown
@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatormarkdown_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func ExampleSection() {
+	validators := []validator.String{
+		stringvalidator.LengthBetween(1, 64),
+		stringvalidator.OneOf("tcp", "udp"),
+	}
+
+	fmt.Println("The protocol of the rule.\n\n" + validatormarkdown.Section(context.Background(), validators))
+
+	// Output:
+	// The protocol of the rule.
+	//
+	// **Constraints:**
+	//
+	// - string length must be between `1` and `64`
+	// - value must be one of:
+	//   - `"tcp"`
+	//   - `"udp"`
+}
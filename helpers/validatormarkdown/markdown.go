@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatormarkdown
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// Describe returns the Markdown description of the validator, rendered from
+// its constraint with Render. It is intended for the MarkdownDescription
+// method of the validator, so it only calls the Constraint and Description
+// methods of the validator.
+func Describe(ctx context.Context, v validatorspec.ValidatorWithConstraint) string {
+	constraint := v.Constraint(ctx)
+
+	if constraint.Description == "" {
+		constraint.Description = v.Description(ctx)
+	}
+
+	return Render(constraint)
+}
+
+// Section returns a Constraints section with a bullet list of the Markdown
+// descriptions of the validators, such as the validators of an attribute,
+// or an empty string if there are no validators.
+func Section[V validator.Describer](ctx context.Context, validators []V) string {
+	if len(validators) == 0 {
+		return ""
+	}
+
+	items := make([]string, 0, len(validators))
+
+	for _, v := range validators {
+		items = append(items, v.MarkdownDescription(ctx))
+	}
+
+	return "**Constraints:**\n\n" + List(items...)
+}
+
+// Code returns the text formatted as inline code.
+func Code(text string) string {
+	// Inline code containing backticks is delimited by more backticks than
+	// the longest sequence of backticks in the text, with spaces so text
+	// beginning or ending with a backtick is preserved.
+	if !strings.Contains(text, "`") {
+		return "`" + text + "`"
+	}
+
+	longest, current := 0, 0
+
+	for _, r := range text {
+		if r != '`' {
+			current = 0
+
+			continue
+		}
+
+		current++
+		longest = max(longest, current)
+	}
+
+	delimiter := strings.Repeat("`", longest+1)
+
+	return delimiter + " " + text + " " + delimiter
+}
+
+// List returns a bullet list of the items. The lines after the first line
+// of each item are indented, so items can contain nested lists.
+func List(items ...string) string {
+	var b strings.Builder
+
+	for i, item := range items {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		b.WriteString("- ")
+
+		for j, line := range strings.Split(item, "\n") {
+			if j > 0 {
+				b.WriteString("\n")
+
+				if line != "" {
+					b.WriteString("  ")
+				}
+			}
+
+			b.WriteString(line)
+		}
+	}
+
+	return b.String()
+}
+
+// Path returns the path expression formatted as code, linked to the anchor
+// of the attribute when the expression only contains attribute names and
+// any element steps, such as [`block[*].attribute`](#block.attribute). The
+// anchor is the attribute names joined by periods.
+func Path(expression path.Expression) string {
+	code := Code(expression.String())
+
+	var names []string
+
+	for _, step := range expression.Resolve().Steps() {
+		switch step := step.(type) {
+		case path.ExpressionStepAttributeNameExact:
+			names = append(names, string(step))
+		case path.ExpressionStepElementKeyIntAny,
+			path.ExpressionStepElementKeyStringAny,
+			path.ExpressionStepElementKeyValueAny:
+			continue
+		default:
+			// Expressions with parent steps or exact element steps cannot
+			// be linked to an attribute anchor.
+			return code
+		}
+	}
+
+	if len(names) == 0 {
+		return code
+	}
+
+	return "[" + code + "](#" + strings.Join(names, ".") + ")"
+}
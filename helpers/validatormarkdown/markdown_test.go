@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatormarkdown_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator validator.String
+		expected  string
+	}{
+		"length": {
+			validator: stringvalidator.LengthBetween(1, 10),
+			expected:  "string length must be between `1` and `10`",
+		},
+		"one-of": {
+			validator: stringvalidator.OneOf("a", "b"),
+			expected:  "value must be one of:\n- `\"a\"`\n- `\"b\"`",
+		},
+		"sequence": {
+			validator: stringvalidator.Sequence(
+				stringvalidator.LengthAtMost(4096),
+				stringvalidator.JSONMatchesSchema(`{"type": "object"}`),
+			),
+			expected: "Value must satisfy each of the validations in order:\n" +
+				"- string length must be at most `4096`\n" +
+				"- value must be a JSON document matching the JSON Schema",
+		},
+		"nested": {
+			validator: stringvalidator.Any(
+				stringvalidator.All(
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexpLowercase, ""),
+				),
+				stringvalidator.ConflictsWith(path.MatchRoot("other")),
+			),
+			expected: "Value must satisfy at least one of the validations:\n" +
+				"- Value must satisfy all of the validations:\n" +
+				"  - string length must be at least `1`\n" +
+				"  - value must match regular expression `^[a-z]+$`\n" +
+				"- Ensure that if an attribute is set, these are not set:\n" +
+				"  - [`other`](#other)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validator.MarkdownDescription(context.Background())
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSection(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.Int64
+		expected   string
+	}{
+		"nil": {
+			expected: "",
+		},
+		"multiple": {
+			validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+				int64validator.NoneOf(22),
+			},
+			expected: "**Constraints:**\n\n" +
+				"- value must be between `1` and `65535`\n" +
+				"- value must be none of:\n" +
+				"  - `22`",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatormarkdown.Section(context.Background(), testCase.validators)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		text     string
+		expected string
+	}{
+		"empty": {
+			text:     "",
+			expected: "``",
+		},
+		"text": {
+			text:     `"a"`,
+			expected: "`\"a\"`",
+		},
+		"backtick": {
+			text:     "a`b",
+			expected: "`` a`b ``",
+		},
+		"backticks": {
+			text:     "``a`",
+			expected: "``` ``a` ```",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatormarkdown.Code(testCase.text)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		items    []string
+		expected string
+	}{
+		"none": {
+			expected: "",
+		},
+		"single": {
+			items:    []string{"a"},
+			expected: "- a",
+		},
+		"multiline": {
+			items:    []string{"a:\n- b\n\n  c", "d"},
+			expected: "- a:\n  - b\n\n    c\n- d",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatormarkdown.List(testCase.items...)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		expected   string
+	}{
+		"root": {
+			expression: path.MatchRoot("name"),
+			expected:   "[`name`](#name)",
+		},
+		"nested": {
+			expression: path.MatchRoot("block").AtAnyListIndex().AtName("name"),
+			expected:   "[`block[*].name`](#block.name)",
+		},
+		"resolved-parent": {
+			expression: path.MatchRoot("block").AtName("other").AtParent().AtName("name"),
+			expected:   "[`block.other.<.name`](#block.name)",
+		},
+		"relative-parent": {
+			expression: path.MatchRelative().AtParent().AtName("name"),
+			expected:   "`<.name`",
+		},
+		"exact-element": {
+			expression: path.MatchRoot("list").AtListIndex(0),
+			expected:   "`list[0]`",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatormarkdown.Path(testCase.expression)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
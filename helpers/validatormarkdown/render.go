@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatormarkdown
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// quotedRegex matches Go quoted strings in descriptions, such as the values
+// of DeprecatedValues.
+var quotedRegex = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+// Render returns the Markdown description of the constraint, based on its
+// plain text Description. Nested constraints are rendered with their
+// MarkdownDescription, if set, so the Markdown descriptions of
+// provider-defined validators are preserved.
+func Render(constraint validatorspec.Constraint) string {
+	lead, hasLead := leadingSentence(constraint.Description)
+
+	switch constraint.Kind {
+	case validatorspec.KindAll,
+		validatorspec.KindSequence,
+		validatorspec.KindAny,
+		validatorspec.KindAnyWithAllWarnings,
+		validatorspec.KindElements,
+		validatorspec.KindKeys:
+		if !hasLead || len(constraint.Children) == 0 {
+			break
+		}
+
+		items := make([]string, 0, len(constraint.Children))
+
+		for _, child := range constraint.Children {
+			items = append(items, nested(child))
+		}
+
+		return lead + "\n" + List(items...)
+	case validatorspec.KindAsWarning:
+		if len(constraint.Children) != 1 {
+			break
+		}
+
+		return nested(constraint.Children[0])
+	case validatorspec.KindOneOf, validatorspec.KindNoneOf:
+		if !hasLead || len(constraint.Values) == 0 {
+			break
+		}
+
+		if constraint.CaseInsensitive {
+			lead = strings.TrimSuffix(lead, ":") + ", ignoring case:"
+		}
+
+		items := make([]string, 0, len(constraint.Values))
+
+		for _, value := range constraint.Values {
+			items = append(items, Code(value.String()))
+		}
+
+		return lead + "\n" + List(items...)
+	case validatorspec.KindAlsoRequires,
+		validatorspec.KindAtLeastOneOf,
+		validatorspec.KindConflicting,
+		validatorspec.KindConflictsWith,
+		validatorspec.KindExactlyOneOf,
+		validatorspec.KindRequiredTogether:
+		if !hasLead || len(constraint.Paths) == 0 {
+			break
+		}
+
+		items := make([]string, 0, len(constraint.Paths))
+
+		for _, expression := range constraint.Paths {
+			items = append(items, Path(expression))
+		}
+
+		return lead + "\n" + List(items...)
+	}
+
+	return inline(constraint)
+}
+
+// nested returns the Markdown description of a nested constraint.
+func nested(constraint validatorspec.Constraint) string {
+	if constraint.MarkdownDescription != "" {
+		return constraint.MarkdownDescription
+	}
+
+	return Render(constraint)
+}
+
+// leadingSentence returns the description up to and including the first
+// colon which is followed by a space, such as "value must be one of:".
+func leadingSentence(description string) (string, bool) {
+	lead, _, found := strings.Cut(description, ": ")
+
+	if !found {
+		return "", false
+	}
+
+	return lead + ":", true
+}
+
+// inline returns the Description of the constraint with its values formatted
+// as code: the Pattern, quoted strings, the Min and Max bounds, and the
+// Paths, which are linked to the anchors of the attributes.
+func inline(constraint validatorspec.Constraint) string {
+	text := markup{{text: constraint.Description}}
+
+	if constraint.Pattern != "" {
+		text = text.replaceFirst("'"+constraint.Pattern+"'", Code(constraint.Pattern))
+		text = text.replaceFirst(constraint.Pattern, Code(constraint.Pattern))
+	}
+
+	for _, expression := range constraint.Paths {
+		text, _ = text.replaceToken(expression.String(), Path(expression))
+	}
+
+	text = text.replaceAll(quotedRegex, Code)
+
+	for _, bound := range []*big.Float{constraint.Min, constraint.Max} {
+		for _, number := range numberTexts(bound) {
+			replaced, ok := text.replaceToken(number, Code(number))
+
+			if ok {
+				text = replaced
+
+				break
+			}
+		}
+	}
+
+	return text.String()
+}
+
+// numberTexts returns the ways the number may be formatted in descriptions,
+// such as 10, 1.5, or 1.500000.
+func numberTexts(number *big.Float) []string {
+	if number == nil || number.IsInf() {
+		return nil
+	}
+
+	f, _ := number.Float64()
+
+	texts := []string{
+		number.Text('f', -1),
+		number.Text('g', -1),
+		fmt.Sprintf("%f", f),
+	}
+
+	if number.IsInt() {
+		texts = append([]string{number.Text('f', 0)}, texts...)
+	}
+
+	return texts
+}
+
+// markup is Markdown text as segments of plain text, which may be formatted,
+// and Markdown, which is not formatted again.
+type markup []segment
+
+type segment struct {
+	text     string
+	markdown bool
+}
+
+func (m markup) String() string {
+	var b strings.Builder
+
+	for _, s := range m {
+		b.WriteString(s.text)
+	}
+
+	return b.String()
+}
+
+// replaceFirst replaces the first occurrence of old in plain text with the
+// Markdown.
+func (m markup) replaceFirst(old string, markdown string) markup {
+	for i, s := range m {
+		if s.markdown {
+			continue
+		}
+
+		before, after, found := strings.Cut(s.text, old)
+
+		if !found {
+			continue
+		}
+
+		return m.splice(i, before, markdown, after)
+	}
+
+	return m
+}
+
+// replaceAll replaces all matches of the regular expression in plain text
+// with the Markdown returned by the function.
+func (m markup) replaceAll(re *regexp.Regexp, fn func(string) string) markup {
+	var result markup
+
+	for _, s := range m {
+		if s.markdown {
+			result = append(result, s)
+
+			continue
+		}
+
+		last := 0
+
+		for _, match := range re.FindAllStringIndex(s.text, -1) {
+			result = append(result,
+				segment{text: s.text[last:match[0]]},
+				segment{text: fn(s.text[match[0]:match[1]]), markdown: true},
+			)
+
+			last = match[1]
+		}
+
+		result = append(result, segment{text: s.text[last:]})
+	}
+
+	return result
+}
+
+// replaceToken replaces the first occurrence of the token in plain text,
+// which is not part of a longer number, word, or path, with the Markdown.
+func (m markup) replaceToken(token string, markdown string) (markup, bool) {
+	re := regexp.MustCompile(`(?:^|[^\w.\-])(` + regexp.QuoteMeta(token) + `)(?:$|[^\w.\[]|\.(?:$|\W))`)
+
+	for i, s := range m {
+		if s.markdown {
+			continue
+		}
+
+		match := re.FindStringSubmatchIndex(s.text)
+
+		if match == nil {
+			continue
+		}
+
+		return m.splice(i, s.text[:match[2]], markdown, s.text[match[3]:]), true
+	}
+
+	return m, false
+}
+
+// splice replaces the segment at the index with the plain text before, the
+// Markdown, and the plain text after.
+func (m markup) splice(i int, before string, markdown string, after string) markup {
+	result := make(markup, 0, len(m)+2)
+	result = append(result, m[:i]...)
+	result = append(result,
+		segment{text: before},
+		segment{text: markdown, markdown: true},
+		segment{text: after},
+	)
+
+	return append(result, m[i+1:]...)
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatormarkdown_test
+
+import (
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+var regexpLowercase = regexp.MustCompile(`^[a-z]+$`)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		constraint validatorspec.Constraint
+		expected   string
+	}{
+		"custom": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindCustom,
+				Description: `value must be "custom"`,
+			},
+			expected: "value must be `\"custom\"`",
+		},
+		"all-children-markdown": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindAll,
+				Description: "Value must satisfy all of the validations: value must be custom + value must be other",
+				Children: []validatorspec.Constraint{
+					{
+						Kind:                validatorspec.KindCustom,
+						Description:         "value must be custom",
+						MarkdownDescription: "value must be **custom**",
+					},
+					{
+						Kind:        validatorspec.KindCustom,
+						Description: "value must be other",
+					},
+				},
+			},
+			expected: "Value must satisfy all of the validations:\n- value must be **custom**\n- value must be other",
+		},
+		"all-without-children": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindAll,
+				Description: "Value must satisfy all of the validations: ",
+			},
+			expected: "Value must satisfy all of the validations: ",
+		},
+		"as-warning": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindAsWarning,
+				Description: "value must be custom",
+				Children: []validatorspec.Constraint{
+					{
+						Kind:                validatorspec.KindCustom,
+						Description:         "value must be custom",
+						MarkdownDescription: "value must be **custom**",
+					},
+				},
+			},
+			expected: "value must be **custom**",
+		},
+		"one-of-case-insensitive": {
+			constraint: validatorspec.Constraint{
+				Kind:            validatorspec.KindOneOf,
+				Description:     `value must be one of: ["a"]`,
+				Values:          validatorspec.StringValues("a"),
+				CaseInsensitive: true,
+			},
+			expected: "value must be one of, ignoring case:\n- `\"a\"`",
+		},
+		"range-float": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindRange,
+				Description: "value must be between 1.500000 and 10.000000",
+				Min:         big.NewFloat(1.5),
+				Max:         big.NewFloat(10),
+			},
+			expected: "value must be between `1.500000` and `10.000000`",
+		},
+		"range-equal-bounds": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindRange,
+				Description: "value must be between 1 and 1",
+				Min:         big.NewFloat(1),
+				Max:         big.NewFloat(1),
+			},
+			expected: "value must be between `1` and `1`",
+		},
+		"range-not-within-words": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindUTF8Length,
+				Description: "UTF-8 character count must be at least 8, not 1.8",
+				Min:         big.NewFloat(8),
+			},
+			expected: "UTF-8 character count must be at least `8`, not 1.8",
+		},
+		"regex-matches": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindRegexMatches,
+				Description: `value must match regular expression '^"[0-9]+"$'`,
+				Pattern:     `^"[0-9]+"$`,
+			},
+			expected: "value must match regular expression `^\"[0-9]+\"$`",
+		},
+		"paths-list": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindExactlyOneOf,
+				Description: `Exactly one of these attributes must be configured: [a,b]`,
+				Paths:       path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+			expected: "Exactly one of these attributes must be configured:\n- [`a`](#a)\n- [`b`](#b)",
+		},
+		"paths-inline": {
+			constraint: validatorspec.Constraint{
+				Kind:        validatorspec.KindAtLeastSumOf,
+				Description: "value must be at least sum of a + a_b",
+				Paths:       path.Expressions{path.MatchRoot("a"), path.MatchRoot("a_b")},
+			},
+			expected: "value must be at least sum of [`a`](#a) + [`a_b`](#a_b)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatormarkdown.Render(testCase.constraint)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorspec
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ValidatorWithConstraint is a validator which describes its validation as
+// a structured Constraint. All validators in this module implement it.
+type ValidatorWithConstraint interface {
+	validator.Describer
+
+	// Constraint returns the structured constraint of the validation. The
+	// Description field may be empty, in which case Of sets it.
+	Constraint(context.Context) Constraint
+}
+
+// Constraint is a structured description of the validation of a validator.
+// The Kind determines which of the other fields are set.
+type Constraint struct {
+	// Kind is the kind of validation.
+	Kind Kind
+
+	// Description is the plain text description of the validator.
+	Description string
+
+	// MarkdownDescription is the Markdown description of the validator.
+	MarkdownDescription string
+
+	// Format is the value format of KindFormat.
+	Format Format
+
+	// Min is the inclusive minimum of KindRange values, KindLength,
+	// KindUTF8Length, and KindPasswordPolicy lengths, KindSize element
+	// counts, KindDelimitedSegments segment counts, FormatDecimal and
+	// FormatInteger values, and FormatQuantity magnitudes in the base unit,
+	// such as bytes. It is nil if there is no minimum.
+	Min *big.Float
+
+	// Max is the inclusive maximum, with the same meaning as Min. It is nil
+	// if there is no maximum.
+	Max *big.Float
+
+	// Values are the allowed values of KindEquals and KindOneOf, the
+	// disallowed values of KindNoneOf, the deprecated values of
+	// KindDeprecatedValues, the forbidden substrings of KindPasswordPolicy,
+	// the allowed PEM block types of FormatPEM, and the allowed key types of
+	// FormatSSHPublicKey and FormatAuthorizedKeysLine.
+	Values []attr.Value
+
+	// CaseInsensitive is true if KindOneOf or KindNoneOf values are compared
+	// case-insensitively.
+	CaseInsensitive bool
+
+	// Pattern is the regular expression of KindRegexMatches.
+	Pattern string
+
+	// Separator is the segment separator of KindDelimitedSegments.
+	Separator string
+
+	// Schema is the JSON Schema document of KindJSONSchema.
+	Schema string
+
+	// Paths are the path expressions of attributes referenced by the
+	// validation, such as those of KindConflictsWith or KindExactlyOneOf.
+	Paths path.Expressions
+
+	// Children are the constraints of nested validators, such as the
+	// validators of KindAll, KindSequence, and KindAny, the element
+	// validators of KindElements, or the segments of KindDelimitedSegments.
+	Children []Constraint
+}
+
+// Kind is the kind of validation of a Constraint.
+type Kind string
+
+const (
+	// KindCustom is a validation without a structured constraint, such as
+	// a provider-defined validator. Only the Description is set.
+	KindCustom Kind = "custom"
+
+	// KindAll requires all Children to be satisfied.
+	KindAll Kind = "all"
+
+	// KindSequence requires all Children to be satisfied, in order, with
+	// validation stopping at the first child which is not satisfied.
+	KindSequence Kind = "sequence"
+
+	// KindAny requires at least one of the Children to be satisfied.
+	KindAny Kind = "any"
+
+	// KindAnyWithAllWarnings requires at least one of the Children to be
+	// satisfied, returning the warnings of all Children.
+	KindAnyWithAllWarnings Kind = "any_with_all_warnings"
+
+	// KindAsWarning reports the errors of its single child as warnings.
+	KindAsWarning Kind = "as_warning"
+
+	// KindEquals requires the value to equal the single value in Values.
+	KindEquals Kind = "equals"
+
+	// KindOneOf requires the value to be one of Values.
+	KindOneOf Kind = "one_of"
+
+	// KindOneOfFunc requires the value to be one of the values returned by
+	// a function during validation, which are not known beforehand.
+	KindOneOfFunc Kind = "one_of_func"
+
+	// KindNoneOf requires the value to not be one of Values.
+	KindNoneOf Kind = "none_of"
+
+	// KindDeprecatedValues warns about, or after a deadline rejects, the
+	// deprecated Values.
+	KindDeprecatedValues Kind = "deprecated_values"
+
+	// KindRange requires the number to be between Min and Max.
+	KindRange Kind = "range"
+
+	// KindLength requires the string length, in bytes, to be between Min
+	// and Max.
+	KindLength Kind = "length"
+
+	// KindUTF8Length requires the string length, in UTF-8 characters, to be
+	// between Min and Max.
+	KindUTF8Length Kind = "utf8_length"
+
+	// KindSize requires the number of collection elements to be between Min
+	// and Max.
+	KindSize Kind = "size"
+
+	// KindRegexMatches requires the string to match the Pattern.
+	KindRegexMatches Kind = "regex_matches"
+
+	// KindFormat requires the string to be of the Format.
+	KindFormat Kind = "format"
+
+	// KindJSONSchema requires the string to be a JSON document matching the
+	// Schema.
+	KindJSONSchema Kind = "json_schema"
+
+	// KindDelimitedSegments requires the string to have between Min and Max
+	// segments delimited by the Separator, with each of the Children, which
+	// are KindAll constraints, applied to the segment at the same index.
+	KindDelimitedSegments Kind = "delimited_segments"
+
+	// KindPasswordPolicy requires the string to satisfy a password policy,
+	// with a length between Min and Max. The forbidden substrings are the
+	// Values and the attributes whose values must not be contained are the
+	// Paths.
+	KindPasswordPolicy Kind = "password_policy"
+
+	// KindNoSecrets warns about, or rejects, strings which appear to contain
+	// credentials.
+	KindNoSecrets Kind = "no_secrets"
+
+	// KindElements requires all Children to be satisfied by each element of
+	// a list or set, or each value of a map.
+	KindElements Kind = "elements"
+
+	// KindKeys requires all Children to be satisfied by each map key.
+	KindKeys Kind = "keys"
+
+	// KindNoNullValues requires collection elements to not be null.
+	KindNoNullValues Kind = "no_null_values"
+
+	// KindUniqueValues requires list elements to be unique.
+	KindUniqueValues Kind = "unique_values"
+
+	// KindRequired requires the value to be configured.
+	KindRequired Kind = "required"
+
+	// KindAlsoRequires requires the attributes of Paths to be configured
+	// when the value is configured.
+	KindAlsoRequires Kind = "also_requires"
+
+	// KindAtLeastOneOf requires at least one of the value and the
+	// attributes of Paths to be configured. For configuration validators,
+	// Paths contains all of the attributes.
+	KindAtLeastOneOf Kind = "at_least_one_of"
+
+	// KindConflictsWith requires the attributes of Paths to not be
+	// configured when the value is configured.
+	KindConflictsWith Kind = "conflicts_with"
+
+	// KindConflicting requires at most one of the attributes of Paths to be
+	// configured.
+	KindConflicting Kind = "conflicting"
+
+	// KindExactlyOneOf requires exactly one of the value and the attributes
+	// of Paths to be configured. For configuration validators, Paths
+	// contains all of the attributes.
+	KindExactlyOneOf Kind = "exactly_one_of"
+
+	// KindRequiredTogether requires all or none of the attributes of Paths
+	// to be configured.
+	KindRequiredTogether Kind = "required_together"
+
+	// KindPreferWriteOnlyAttribute warns when the value is configured and
+	// the write-only attribute, the last of Paths, is supported. For
+	// configuration validators, Paths starts with the replaced attribute.
+	KindPreferWriteOnlyAttribute Kind = "prefer_write_only_attribute"
+
+	// KindAtLeastSumOf requires the number to be at least the sum of the
+	// attributes of Paths.
+	KindAtLeastSumOf Kind = "at_least_sum_of"
+
+	// KindAtMostSumOf requires the number to be at most the sum of the
+	// attributes of Paths.
+	KindAtMostSumOf Kind = "at_most_sum_of"
+
+	// KindEqualToSumOf requires the number to equal the sum of the
+	// attributes of Paths.
+	KindEqualToSumOf Kind = "equal_to_sum_of"
+
+	// KindEqualToProductOf requires the number to equal the product of the
+	// attributes of Paths.
+	KindEqualToProductOf Kind = "equal_to_product_of"
+)
+
+// Format is the string format of a KindFormat Constraint.
+type Format string
+
+// Formats of the stringvalidator format validators, such as IsARN and
+// IsCronExpression.
+const (
+	FormatARN                       Format = "arn"
+	FormatAuthorizedKeysLine        Format = "authorized_keys_line"
+	FormatCertificateChain          Format = "certificate_chain"
+	FormatCronExpression            Format = "cron_expression"
+	FormatCronExpressionWithSeconds Format = "cron_expression_with_seconds"
+	FormatDecimal                   Format = "decimal"
+	FormatGlobPattern               Format = "glob_pattern"
+	FormatInteger                   Format = "integer"
+	FormatPEM                       Format = "pem"
+	FormatPrivateKey                Format = "private_key"
+	FormatQuantity                  Format = "quantity"
+	FormatRegex                     Format = "regex"
+	FormatSSHPublicKey              Format = "ssh_public_key"
+	FormatTimeZone                  Format = "time_zone"
+	FormatWeeklyWindow              Format = "weekly_window"
+	FormatX509Certificate           Format = "x509_certificate"
+)
+
+// Of returns the constraint of the validator, with the Description and
+// MarkdownDescription set from the validator if empty. Validators which do not implement
+// ValidatorWithConstraint, such as provider-defined validators, return a
+// KindCustom constraint.
+func Of(ctx context.Context, v any) Constraint {
+	var constraint Constraint
+
+	if withConstraint, ok := v.(ValidatorWithConstraint); ok {
+		constraint = withConstraint.Constraint(ctx)
+	} else {
+		constraint.Kind = KindCustom
+	}
+
+	if describer, ok := v.(validator.Describer); ok {
+		if constraint.Description == "" {
+			constraint.Description = describer.Description(ctx)
+		}
+
+		if constraint.MarkdownDescription == "" {
+			constraint.MarkdownDescription = describer.MarkdownDescription(ctx)
+		}
+	}
+
+	return constraint
+}
+
+// OfAll returns the constraints of the validators, in order.
+func OfAll[V any](ctx context.Context, validators []V) []Constraint {
+	if len(validators) == 0 {
+		return nil
+	}
+
+	constraints := make([]Constraint, 0, len(validators))
+
+	for _, v := range validators {
+		constraints = append(constraints, Of(ctx, v))
+	}
+
+	return constraints
+}
+
+// Values converts the values, such as a []types.String, into Constraint
+// Values.
+func Values[T attr.Value](values []T) []attr.Value {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]attr.Value, 0, len(values))
+
+	for _, value := range values {
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// StringValues converts the strings into Constraint Values.
+func StringValues(values ...string) []attr.Value {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]attr.Value, 0, len(values))
+
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+
+	return result
+}
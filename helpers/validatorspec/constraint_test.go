@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorspec_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+// describedValidator is a validator without a structured constraint.
+type describedValidator struct{}
+
+func (v describedValidator) Description(_ context.Context) string {
+	return "value must be described"
+}
+
+func (v describedValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be _described_"
+}
+
+func (v describedValidator) ValidateString(_ context.Context, _ validator.StringRequest, _ *validator.StringResponse) {
+}
+
+// describedConstraintValidator is a validator with a structured constraint
+// which sets its own description.
+type describedConstraintValidator struct {
+	describedValidator
+}
+
+func (v describedConstraintValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:        validatorspec.KindRequired,
+		Description: "constraint description",
+	}
+}
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"nil": {
+			validator: nil,
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindCustom,
+			},
+		},
+		"custom": {
+			validator: describedValidator{},
+			expected: validatorspec.Constraint{
+				Kind:                validatorspec.KindCustom,
+				Description:         "value must be described",
+				MarkdownDescription: "value must be _described_",
+			},
+		},
+		"constraint-description": {
+			validator: describedConstraintValidator{},
+			expected: validatorspec.Constraint{
+				Kind:                validatorspec.KindRequired,
+				Description:         "constraint description",
+				MarkdownDescription: "value must be _described_",
+			},
+		},
+		"built-in": {
+			validator: stringvalidator.LengthBetween(1, 10),
+			expected: validatorspec.Constraint{
+				Kind:                validatorspec.KindLength,
+				Description:         "string length must be between 1 and 10",
+				MarkdownDescription: "string length must be between `1` and `10`",
+				Min:                 big.NewFloat(1),
+				Max:                 big.NewFloat(10),
+			},
+		},
+		"nested": {
+			validator: stringvalidator.Any(
+				stringvalidator.OneOf("a", "b"),
+				stringvalidator.ConflictsWith(path.MatchRoot("other")),
+				describedValidator{},
+			),
+			expected: validatorspec.Constraint{
+				Kind:                validatorspec.KindAny,
+				Description:         `Value must satisfy at least one of the validations: value must be one of: ["a" "b"] + Ensure that if an attribute is set, these are not set: "[other]" + value must be described`,
+				MarkdownDescription: "Value must satisfy at least one of the validations:\n- value must be one of:\n  - `\"a\"`\n  - `\"b\"`\n- Ensure that if an attribute is set, these are not set:\n  - [`other`](#other)\n- value must be _described_",
+				Children: []validatorspec.Constraint{
+					{
+						Kind:                validatorspec.KindOneOf,
+						Description:         `value must be one of: ["a" "b"]`,
+						MarkdownDescription: "value must be one of:\n- `\"a\"`\n- `\"b\"`",
+						Values:              []attr.Value{types.StringValue("a"), types.StringValue("b")},
+					},
+					{
+						Kind:                validatorspec.KindConflictsWith,
+						Description:         `Ensure that if an attribute is set, these are not set: "[other]"`,
+						MarkdownDescription: "Ensure that if an attribute is set, these are not set:\n- [`other`](#other)",
+						Paths:               path.Expressions{path.MatchRoot("other")},
+					},
+					{
+						Kind:                validatorspec.KindCustom,
+						Description:         "value must be described",
+						MarkdownDescription: "value must be _described_",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOfAll(t *testing.T) {
+	t.Parallel()
+
+	got := validatorspec.OfAll(context.Background(), []validator.String{
+		stringvalidator.LengthAtLeast(1),
+		describedValidator{},
+	})
+
+	expected := []validatorspec.Constraint{
+		{
+			Kind:                validatorspec.KindLength,
+			Description:         "string length must be at least 1",
+			MarkdownDescription: "string length must be at least `1`",
+			Min:                 big.NewFloat(1),
+		},
+		{
+			Kind:                validatorspec.KindCustom,
+			Description:         "value must be described",
+			MarkdownDescription: "value must be _described_",
+		},
+	}
+
+	if diff := cmp.Diff(expected, got, testvalidator.ConstraintCmpOptions); diff != "" {
+		t.Errorf("unexpected constraints difference: %s", diff)
+	}
+
+	if got := validatorspec.OfAll[validator.String](context.Background(), nil); got != nil {
+		t.Errorf("expected nil constraints, got: %v", got)
+	}
+}
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+
+	got := validatorspec.Values([]types.Int64{types.Int64Value(1), types.Int64Null()})
+	expected := []attr.Value{types.Int64Value(1), types.Int64Null()}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected values difference: %s", diff)
+	}
+
+	if got := validatorspec.Values[types.Int64](nil); got != nil {
+		t.Errorf("expected nil values, got: %v", got)
+	}
+}
+
+func TestStringValues(t *testing.T) {
+	t.Parallel()
+
+	got := validatorspec.StringValues("a", "b")
+	expected := []attr.Value{types.StringValue("a"), types.StringValue("b")}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected values difference: %s", diff)
+	}
+
+	if got := validatorspec.StringValues(); got != nil {
+		t.Errorf("expected nil values, got: %v", got)
+	}
+}
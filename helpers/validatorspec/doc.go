@@ -0,0 +1,8 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validatorspec provides structured descriptions of validator
+// constraints, such as the bounds of Between or the values of OneOf, for
+// tools which generate documentation, JSON Schema, or user interfaces from
+// provider schemas.
+package validatorspec
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorspec_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func ExampleSchemaConstraints() {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tcp", "udp"),
+				},
+			},
+		},
+	}
+
+	for _, attribute := range validatorspec.SchemaConstraints(context.Background(), s) {
+		for _, constraint := range attribute.Constraints {
+			switch constraint.Kind {
+			case validatorspec.KindRange:
+				fmt.Printf("%s: minimum %v, maximum %v\n", attribute.Path, constraint.Min, constraint.Max)
+			case validatorspec.KindOneOf:
+				fmt.Printf("%s: one of %s\n", attribute.Path, constraint.Values)
+			}
+		}
+	}
+
+	// Output:
+	// port: minimum 1, maximum 65535
+	// protocol: one of ["tcp" "udp"]
+}
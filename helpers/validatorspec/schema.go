@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorspec
+
+import (
+	"context"
+	"slices"
+
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/schemareflect"
+)
+
+// Schema is the constraint of schemas which can be walked.
+type Schema interface {
+	actionschema.Schema |
+		datasourceschema.Schema |
+		ephemeralschema.Schema |
+		listschema.Schema |
+		providerschema.Schema |
+		resourceschema.Schema
+}
+
+// AttributeConstraints are the constraints of the validators of an
+// attribute or block.
+type AttributeConstraints struct {
+	// Path is the expression of the attribute or block. The elements of
+	// nested attributes and blocks are matched with AtAnyListIndex,
+	// AtAnySetValue, or AtAnyMapKey, such as "block[*].attribute".
+	Path path.Expression
+
+	// Constraints are the constraints of the validators, in order.
+	Constraints []Constraint
+}
+
+// SchemaConstraints walks the attributes and blocks of the schema,
+// including nested attributes and blocks, and returns the constraints of
+// those with validators, depth-first in order of name. The object validators
+// of nested attributes and blocks are returned with the path of their
+// elements.
+func SchemaConstraints[S Schema](ctx context.Context, schema S) []AttributeConstraints {
+	w := walker{ctx: ctx}
+
+	w.walkObject(path.Expression{}, any(schema))
+
+	return w.result
+}
+
+// walker collects the constraints of schema attributes and blocks.
+type walker struct {
+	ctx    context.Context
+	result []AttributeConstraints
+}
+
+// walkObject walks the attributes and blocks of a schema or nested object.
+// The framework types of attributes, blocks, and nested objects are
+// internal, so they are accessed by their exported methods.
+func (w *walker) walkObject(expression path.Expression, object any) {
+	attributes := schemareflect.Attributes(object)
+	blocks := schemareflect.Blocks(object)
+
+	names := make([]string, 0, len(attributes)+len(blocks))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	for name := range blocks {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		var child any
+
+		if attribute, ok := attributes[name]; ok {
+			child = attribute
+		} else {
+			child = blocks[name]
+		}
+
+		childExpression := path.MatchRoot(name)
+
+		if len(expression.Steps()) > 0 {
+			childExpression = expression.AtName(name)
+		}
+
+		w.add(childExpression, child)
+
+		nestedObject, ok := schemareflect.NestedObject(child)
+
+		if !ok {
+			continue
+		}
+
+		elementExpression := w.elementExpression(childExpression, child)
+
+		// The nested objects of single nested attributes and blocks return
+		// the same validators as the attribute or block.
+		if !elementExpression.Equal(childExpression) {
+			w.add(elementExpression, nestedObject)
+		}
+
+		w.walkObject(elementExpression, nestedObject)
+	}
+}
+
+// add adds the constraints of the validators of the attribute, block, or
+// nested object, if any.
+func (w *walker) add(expression path.Expression, value any) {
+	constraints := OfAll(w.ctx, schemareflect.Validators(value))
+
+	if len(constraints) == 0 {
+		return
+	}
+
+	w.result = append(w.result, AttributeConstraints{
+		Path:        expression,
+		Constraints: constraints,
+	})
+}
+
+// elementExpression returns the expression of the elements of the nested
+// attribute or block.
+func (w *walker) elementExpression(expression path.Expression, value any) path.Expression {
+	var typ attr.Type
+
+	// Attributes and blocks have differently named type methods.
+	switch v := value.(type) {
+	case interface{ GetType() attr.Type }:
+		typ = v.GetType()
+	case interface{ Type() attr.Type }:
+		typ = v.Type()
+	default:
+		return expression
+	}
+
+	tfType := typ.TerraformType(w.ctx)
+
+	switch {
+	case tfType.Is(tftypes.List{}):
+		return expression.AtAnyListIndex()
+	case tfType.Is(tftypes.Set{}):
+		return expression.AtAnySetValue()
+	case tfType.Is(tftypes.Map{}):
+		return expression.AtAnyMapKey()
+	default:
+		return expression
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatorspec_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestSchemaConstraints(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(10),
+				},
+			},
+			"no_validators": schema.StringAttribute{
+				Optional: true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(2),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+					},
+					Validators: []validator.Object{
+						objectvalidator.IsRequired(),
+					},
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				Optional: true,
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("a"),
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tags": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := validatorspec.SchemaConstraints(context.Background(), s)
+
+	expected := []validatorspec.AttributeConstraints{
+		{
+			Path: path.MatchRoot("name"),
+			Constraints: []validatorspec.Constraint{
+				{
+					Kind:                validatorspec.KindLength,
+					Description:         "string length must be at most 10",
+					MarkdownDescription: "string length must be at most `10`",
+					Max:                 big.NewFloat(10),
+				},
+			},
+		},
+		{
+			Path: path.MatchRoot("rules"),
+			Constraints: []validatorspec.Constraint{
+				{
+					Kind:                validatorspec.KindSize,
+					Description:         "list must contain at most 2 elements",
+					MarkdownDescription: "list must contain at most `2` elements",
+					Max:                 big.NewFloat(2),
+				},
+			},
+		},
+		{
+			Path: path.MatchRoot("rules").AtAnyListIndex(),
+			Constraints: []validatorspec.Constraint{
+				{
+					Kind:                validatorspec.KindRequired,
+					Description:         "must have a configuration value as the provider has marked it as required",
+					MarkdownDescription: "must have a configuration value as the provider has marked it as required",
+				},
+			},
+		},
+		{
+			Path: path.MatchRoot("rules").AtAnyListIndex().AtName("port"),
+			Constraints: []validatorspec.Constraint{
+				{
+					Kind:                validatorspec.KindRange,
+					Description:         "value must be between 1 and 65535",
+					MarkdownDescription: "value must be between `1` and `65535`",
+					Min:                 big.NewFloat(1),
+					Max:                 big.NewFloat(65535),
+				},
+			},
+		},
+		{
+			Path: path.MatchRoot("settings"),
+			Constraints: []validatorspec.Constraint{
+				{
+					Kind:                validatorspec.KindAlsoRequires,
+					Description:         `Ensure that if an attribute is set, also these are set: "[name]"`,
+					MarkdownDescription: "Ensure that if an attribute is set, also these are set:\n- [`name`](#name)",
+					Paths:               path.Expressions{path.MatchRoot("name")},
+				},
+			},
+		},
+		{
+			Path: path.MatchRoot("settings").AtName("mode"),
+			Constraints: []validatorspec.Constraint{
+				{
+					Kind:                validatorspec.KindOneOf,
+					Description:         `value must be one of: ["a"]`,
+					MarkdownDescription: "value must be one of:\n- `\"a\"`",
+					Values:              validatorspec.StringValues("a"),
+				},
+			},
+		},
+		{
+			Path: path.MatchRoot("tags").AtAnySetValue().AtName("key"),
+			Constraints: []validatorspec.Constraint{
+				{
+					Kind:                validatorspec.KindLength,
+					Description:         "string length must be at least 1",
+					MarkdownDescription: "string length must be at least `1`",
+					Min:                 big.NewFloat(1),
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, got, testvalidator.ConstraintCmpOptions); diff != "" {
+		t.Errorf("unexpected constraints difference: %s", diff)
+	}
+}
+
+func TestSchemaConstraintsSingleNestedBlock(t *testing.T) {
+	t.Parallel()
+
+	s := datasourceschema.Schema{
+		Blocks: map[string]datasourceschema.Block{
+			"filter": datasourceschema.SingleNestedBlock{
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+				Attributes: map[string]datasourceschema.Attribute{
+					"name": datasourceschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	got := validatorspec.SchemaConstraints(context.Background(), s)
+
+	expected := []validatorspec.AttributeConstraints{
+		{
+			Path: path.MatchRoot("filter"),
+			Constraints: []validatorspec.Constraint{
+				{
+					Kind:                validatorspec.KindRequired,
+					Description:         "must have a configuration value as the provider has marked it as required",
+					MarkdownDescription: "must have a configuration value as the provider has marked it as required",
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, got, testvalidator.ConstraintCmpOptions); diff != "" {
+		t.Errorf("unexpected constraints difference: %s", diff)
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"reflect"
+	"slices"
+	"testing"
+
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Schema is the constraint of schemas which configurations can be built
+// from.
+type Schema interface {
+	actionschema.Schema |
+		datasourceschema.Schema |
+		ephemeralschema.Schema |
+		listschema.Schema |
+		providerschema.Schema |
+		resourceschema.Schema
+}
+
+// Config returns a configuration of the schema with the given values, keyed
+// by attribute or block name, or fails the test if the values do not conform
+// to the schema. Refer to NewConfig for the supported values.
+func Config[S Schema](t testing.TB, schema S, values map[string]any) tfsdk.Config {
+	t.Helper()
+
+	config, err := NewConfig(context.Background(), schema, values)
+
+	if err != nil {
+		t.Fatalf("unable to build configuration: %s", err)
+	}
+
+	return config
+}
+
+// NewConfig returns a configuration of the schema with the given values,
+// keyed by attribute or block name. Attributes and blocks without a value
+// are null. Each value can be:
+//
+//   - nil for a null value
+//   - tftypes.UnknownValue for an unknown value
+//   - an attr.Value, such as types.StringValue("example")
+//   - a tftypes.Value of the attribute type
+//   - a string for String values
+//   - a bool for Bool values
+//   - an integer, float, or *big.Float for Number values
+//   - a slice for List, Set, or Tuple values
+//   - a map with string keys for Map values
+//   - a map[string]any for Object values and nested attributes or blocks
+//
+// Dynamic attributes accept string, bool, and number values, or an
+// attr.Value or tftypes.Value for other types.
+func NewConfig[S Schema](ctx context.Context, schema S, values map[string]any) (tfsdk.Config, error) {
+	var config tfsdk.Config
+
+	switch s := any(schema).(type) {
+	case actionschema.Schema:
+		config.Schema = s
+	case datasourceschema.Schema:
+		config.Schema = s
+	case ephemeralschema.Schema:
+		config.Schema = s
+	case listschema.Schema:
+		config.Schema = s
+	case providerschema.Schema:
+		config.Schema = s
+	case resourceschema.Schema:
+		config.Schema = s
+	}
+
+	if values == nil {
+		values = map[string]any{}
+	}
+
+	raw, err := terraformValue(ctx, config.Schema.Type().TerraformType(ctx), values)
+
+	if err != nil {
+		return tfsdk.Config{}, err
+	}
+
+	config.Raw = raw
+
+	return config, nil
+}
+
+// terraformValue converts the Go value into a value of the given type.
+func terraformValue(ctx context.Context, typ tftypes.Type, value any) (tftypes.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return tftypes.NewValue(typ, nil), nil
+	case tftypes.Value:
+		if !v.Type().UsableAs(typ) {
+			return tftypes.Value{}, fmt.Errorf("value of type %s cannot be used as %s", v.Type(), typ)
+		}
+
+		return v, nil
+	case attr.Value:
+		tfValue, err := v.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return terraformValue(ctx, typ, tfValue)
+	}
+
+	if value == any(tftypes.UnknownValue) {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.DynamicPseudoType):
+		return dynamicTerraformValue(ctx, value)
+	case typ.Is(tftypes.String):
+		v, ok := value.(string)
+
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected string for %s, got: %T", typ, value)
+		}
+
+		return tftypes.NewValue(typ, v), nil
+	case typ.Is(tftypes.Bool):
+		v, ok := value.(bool)
+
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected bool for %s, got: %T", typ, value)
+		}
+
+		return tftypes.NewValue(typ, v), nil
+	case typ.Is(tftypes.Number):
+		v, err := bigFloat(value)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(typ, v), nil
+	case typ.Is(tftypes.List{}):
+		return collectionTerraformValue(ctx, typ, typ.(tftypes.List).ElementType, value)
+	case typ.Is(tftypes.Set{}):
+		return collectionTerraformValue(ctx, typ, typ.(tftypes.Set).ElementType, value)
+	case typ.Is(tftypes.Tuple{}):
+		return tupleTerraformValue(ctx, typ.(tftypes.Tuple), value)
+	case typ.Is(tftypes.Map{}):
+		return mapTerraformValue(ctx, typ.(tftypes.Map), value)
+	case typ.Is(tftypes.Object{}):
+		return objectTerraformValue(ctx, typ.(tftypes.Object), value)
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+// dynamicTerraformValue converts the Go value into a value of the type
+// inferred from the Go type.
+func dynamicTerraformValue(ctx context.Context, value any) (tftypes.Value, error) {
+	switch value.(type) {
+	case string:
+		return terraformValue(ctx, tftypes.String, value)
+	case bool:
+		return terraformValue(ctx, tftypes.Bool, value)
+	}
+
+	if _, err := bigFloat(value); err == nil {
+		return terraformValue(ctx, tftypes.Number, value)
+	}
+
+	return tftypes.Value{}, fmt.Errorf("unable to infer dynamic value type of %T, use an attr.Value or tftypes.Value", value)
+}
+
+// bigFloat converts the Go number into a *big.Float.
+func bigFloat(value any) (*big.Float, error) {
+	if v, ok := value.(*big.Float); ok {
+		return v, nil
+	}
+
+	rv := reflect.ValueOf(value)
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Float).SetUint64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return big.NewFloat(rv.Float()), nil
+	default:
+		return nil, fmt.Errorf("expected number, got: %T", value)
+	}
+}
+
+// collectionTerraformValue converts the Go slice into a list or set value.
+func collectionTerraformValue(ctx context.Context, typ tftypes.Type, elementType tftypes.Type, value any) (tftypes.Value, error) {
+	rv := reflect.ValueOf(value)
+
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return tftypes.Value{}, fmt.Errorf("expected slice for %s, got: %T", typ, value)
+	}
+
+	elements := make([]tftypes.Value, 0, rv.Len())
+
+	for i := range rv.Len() {
+		element, err := terraformValue(ctx, elementType, rv.Index(i).Interface())
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("element %d: %w", i, err)
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// tupleTerraformValue converts the Go slice into a tuple value.
+func tupleTerraformValue(ctx context.Context, typ tftypes.Tuple, value any) (tftypes.Value, error) {
+	rv := reflect.ValueOf(value)
+
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return tftypes.Value{}, fmt.Errorf("expected slice for %s, got: %T", typ, value)
+	}
+
+	if rv.Len() != len(typ.ElementTypes) {
+		return tftypes.Value{}, fmt.Errorf("expected %d elements for %s, got: %d", len(typ.ElementTypes), typ, rv.Len())
+	}
+
+	elements := make([]tftypes.Value, 0, rv.Len())
+
+	for i, elementType := range typ.ElementTypes {
+		element, err := terraformValue(ctx, elementType, rv.Index(i).Interface())
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("element %d: %w", i, err)
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// mapTerraformValue converts the Go map into a map value.
+func mapTerraformValue(ctx context.Context, typ tftypes.Map, value any) (tftypes.Value, error) {
+	rv := reflect.ValueOf(value)
+
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return tftypes.Value{}, fmt.Errorf("expected map with string keys for %s, got: %T", typ, value)
+	}
+
+	elements := make(map[string]tftypes.Value, rv.Len())
+
+	for _, key := range rv.MapKeys() {
+		element, err := terraformValue(ctx, typ.ElementType, rv.MapIndex(key).Interface())
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("element %q: %w", key.String(), err)
+		}
+
+		elements[key.String()] = element
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// objectTerraformValue converts the Go map into an object value. Missing
+// attributes are null.
+func objectTerraformValue(ctx context.Context, typ tftypes.Object, value any) (tftypes.Value, error) {
+	values, ok := value.(map[string]any)
+
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("expected map[string]any for %s, got: %T", typ, value)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		if _, ok := typ.AttributeTypes[name]; !ok {
+			return tftypes.Value{}, fmt.Errorf("unexpected attribute %q", name)
+		}
+	}
+
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for name, attributeType := range typ.AttributeTypes {
+		attribute, err := terraformValue(ctx, attributeType, values[name])
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("attribute %q: %w", name, err)
+		}
+
+		attributes[name] = attribute
+	}
+
+	return tftypes.NewValue(typ, attributes), nil
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+)
+
+func testConfigSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bool":    schema.BoolAttribute{Optional: true},
+			"dynamic": schema.DynamicAttribute{Optional: true},
+			"int64":   schema.Int64Attribute{Optional: true},
+			"list":    schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"map":     schema.MapAttribute{ElementType: types.Int64Type, Optional: true},
+			"number":  schema.NumberAttribute{Optional: true},
+			"object": schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{"name": types.StringType},
+				Optional:       true,
+			},
+			"set":    schema.SetAttribute{ElementType: types.StringType, Optional: true},
+			"string": schema.StringAttribute{Optional: true},
+			"nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{Optional: true},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+
+	config := validatortest.Config(t, testConfigSchema(), map[string]any{
+		"bool":    true,
+		"dynamic": "example",
+		"int64":   42,
+		"list":    []string{"a", "b"},
+		"map":     map[string]int{"key": 1},
+		"number":  big.NewFloat(1.5),
+		"object":  map[string]any{"name": "example"},
+		"set":     []any{"a", tftypes.UnknownValue},
+		"string":  types.StringValue("example"),
+		"nested": []any{
+			map[string]any{"name": "first"},
+			map[string]any{},
+		},
+	})
+
+	tests := map[string]struct {
+		path     path.Path
+		expected attr.Value
+	}{
+		"bool": {
+			path:     path.Root("bool"),
+			expected: types.BoolValue(true),
+		},
+		"dynamic": {
+			path:     path.Root("dynamic"),
+			expected: types.DynamicValue(types.StringValue("example")),
+		},
+		"int64": {
+			path:     path.Root("int64"),
+			expected: types.Int64Value(42),
+		},
+		"list": {
+			path:     path.Root("list"),
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+		},
+		"map": {
+			path:     path.Root("map"),
+			expected: types.MapValueMust(types.Int64Type, map[string]attr.Value{"key": types.Int64Value(1)}),
+		},
+		"number": {
+			path:     path.Root("number"),
+			expected: types.NumberValue(big.NewFloat(1.5)),
+		},
+		"object": {
+			path: path.Root("object"),
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{"name": types.StringType},
+				map[string]attr.Value{"name": types.StringValue("example")},
+			),
+		},
+		"set": {
+			path:     path.Root("set"),
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringUnknown()}),
+		},
+		"string": {
+			path:     path.Root("string"),
+			expected: types.StringValue("example"),
+		},
+		"nested-element": {
+			path:     path.Root("nested").AtListIndex(0).AtName("name"),
+			expected: types.StringValue("first"),
+		},
+		"nested-element-missing-attribute": {
+			path:     path.Root("nested").AtListIndex(1).AtName("name"),
+			expected: types.StringNull(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got attr.Value
+
+			diags := config.GetAttribute(context.Background(), test.path, &got)
+
+			validatortest.ExpectNoDiagnostics(t, diags)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewConfig_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]map[string]any{
+		"unexpected-attribute": {
+			"unknown": "example",
+		},
+		"string-type": {
+			"string": 1,
+		},
+		"bool-type": {
+			"bool": "true",
+		},
+		"number-type": {
+			"int64": "42",
+		},
+		"list-type": {
+			"list": "a",
+		},
+		"list-element-type": {
+			"list": []any{1},
+		},
+		"map-type": {
+			"map": []int{1},
+		},
+		"object-type": {
+			"object": "example",
+		},
+		"attr-value-type": {
+			"string": types.Int64Value(1),
+		},
+		"dynamic-type": {
+			"dynamic": []string{"a"},
+		},
+	}
+
+	for name, values := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := validatortest.NewConfig(context.Background(), testConfigSchema(), values)
+
+			if err == nil {
+				t.Fatal("expected error, got no error")
+			}
+		})
+	}
+}
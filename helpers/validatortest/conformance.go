@@ -0,0 +1,350 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// defaultConformanceRandomValues is the default number of random values
+// validated for each validator type.
+const defaultConformanceRandomValues = 100
+
+// defaultConformanceSeed is the default seed of randomly generated values, so
+// conformance tests are reproducible unless randomization is opted into.
+const defaultConformanceSeed = 1
+
+// ConformanceSeedEnvVar is the environment variable which sets the seed of
+// randomly generated values when ConformanceOptions.Seed is not set. The
+// value is either a seed, such as one included in a test failure message, or
+// "random" for a random seed, which is logged.
+const ConformanceSeedEnvVar = "VALIDATORTEST_CONFORMANCE_SEED"
+
+// maxDescribedValueLength is the maximum length of values in test failure
+// messages.
+const maxDescribedValueLength = 80
+
+// ConformanceOptions are the options of RunConformanceWithOptions. Zero
+// values use the defaults.
+type ConformanceOptions struct {
+	// Request is used to build schema validator requests, such as to set a
+	// configuration for validators which reference other attributes.
+	Request Request
+
+	// ElementType is the element type of generated List, Map, and Set
+	// values. Defaults to types.StringType.
+	ElementType attr.Type
+
+	// AttributeTypes are the attribute types of generated Object values.
+	// Defaults to a single "attr" attribute of types.StringType.
+	AttributeTypes map[string]attr.Type
+
+	// Values are additional values to validate, such as values which are
+	// known to be valid or invalid. Values which do not match a type
+	// implemented by the validator are ignored.
+	Values []attr.Value
+
+	// RandomValues is the number of randomly generated values to validate
+	// for each type implemented by the validator. Defaults to 100.
+	RandomValues int
+
+	// Seed is the seed of randomly generated values, which is included in
+	// test failure messages to reproduce failures. Defaults to the value of
+	// the ConformanceSeedEnvVar environment variable, if set, otherwise a
+	// fixed seed.
+	Seed uint64
+}
+
+// elementType returns the element type of generated collection values.
+func (o ConformanceOptions) elementType() attr.Type {
+	if o.ElementType == nil {
+		return types.StringType
+	}
+
+	return o.ElementType
+}
+
+// attributeTypes returns the attribute types of generated object values.
+func (o ConformanceOptions) attributeTypes() map[string]attr.Type {
+	if o.AttributeTypes == nil {
+		return map[string]attr.Type{"attr": types.StringType}
+	}
+
+	return o.AttributeTypes
+}
+
+// randomValues returns the number of random values to validate.
+func (o ConformanceOptions) randomValues() int {
+	if o.RandomValues == 0 {
+		return defaultConformanceRandomValues
+	}
+
+	return o.RandomValues
+}
+
+// seed returns the seed of randomly generated values.
+func (o ConformanceOptions) seed(t testing.TB) uint64 {
+	t.Helper()
+
+	if o.Seed != 0 {
+		return o.Seed
+	}
+
+	env := os.Getenv(ConformanceSeedEnvVar)
+
+	switch env {
+	case "":
+		return defaultConformanceSeed
+	case "random":
+		seed := rand.Uint64()
+
+		t.Logf("using random seed %d", seed)
+
+		return seed
+	}
+
+	seed, err := strconv.ParseUint(env, 10, 64)
+
+	if err != nil {
+		t.Fatalf("invalid %s environment variable value %q, must be a seed or \"random\": %s", ConformanceSeedEnvVar, env, err)
+	}
+
+	return seed
+}
+
+// RunConformance runs the conformance tests of RunConformanceWithOptions with
+// the default options.
+func RunConformance(t testing.TB, v any) {
+	t.Helper()
+
+	RunConformanceWithOptions(t, v, ConformanceOptions{})
+}
+
+// RunConformanceWithOptions tests that the validator, which must implement at
+// least one of the validator.* schema validator or function.*ParameterValidator
+// interfaces, follows the conventions of validators:
+//
+//   - Description and MarkdownDescription are not empty
+//   - null and unknown values are skipped without diagnostics
+//   - null, unknown, boundary, and randomly generated values, such as empty
+//     collections and collections with null or unknown elements, do not
+//     cause a panic
+//   - when both the schema and function parameter interfaces of a type are
+//     implemented, both return an error for the same values
+//
+// Test failure messages are prefixed with the name of the type, such as
+// "String".
+func RunConformanceWithOptions(t testing.TB, v any, opts ConformanceOptions) {
+	t.Helper()
+
+	ctx := context.Background()
+	seed := opts.seed(t)
+
+	if describer, ok := v.(validator.Describer); ok {
+		if describer.Description(ctx) == "" {
+			t.Errorf("%T Description is empty", v)
+		}
+
+		if describer.MarkdownDescription(ctx) == "" {
+			t.Errorf("%T MarkdownDescription is empty", v)
+		}
+	}
+
+	implemented := false
+
+	for _, kind := range conformanceKinds {
+		schemaValidate, hasSchema := kind.schema(v)
+		parameterValidate, hasParameter := kind.parameter(v)
+
+		if !hasSchema && !hasParameter {
+			continue
+		}
+
+		implemented = true
+
+		c := conformance{
+			ctx:               ctx,
+			name:              kind.name,
+			opts:              opts,
+			seed:              seed,
+			typ:               kind.typ(opts),
+			schemaValidate:    schemaValidate,
+			parameterValidate: parameterValidate,
+		}
+
+		c.run(t)
+	}
+
+	if !implemented {
+		t.Fatalf("%T does not implement any validator.* or function.*ParameterValidator interface", v)
+	}
+}
+
+// schemaValidateFunc runs a schema validator with the given value.
+type schemaValidateFunc func(context.Context, Request, attr.Value) diag.Diagnostics
+
+// parameterValidateFunc runs a function parameter validator with the given
+// value.
+type parameterValidateFunc func(context.Context, attr.Value) *function.FuncError
+
+// conformance tests a validator of a single type.
+type conformance struct {
+	ctx               context.Context
+	name              string
+	opts              ConformanceOptions
+	seed              uint64
+	typ               attr.Type
+	schemaValidate    schemaValidateFunc
+	parameterValidate parameterValidateFunc
+}
+
+func (c conformance) run(t testing.TB) {
+	t.Helper()
+
+	tfType := c.typ.TerraformType(c.ctx)
+
+	for name, tfValue := range map[string]tftypes.Value{
+		"null":    tftypes.NewValue(tfType, nil),
+		"unknown": tftypes.NewValue(tfType, tftypes.UnknownValue),
+	} {
+		value, err := c.typ.ValueFromTerraform(c.ctx, tfValue)
+
+		if err != nil {
+			t.Fatalf("%s: unable to create %s value of type %s: %s", c.name, name, c.typ, err)
+		}
+
+		diags, funcErr, _ := c.validate(t, value)
+
+		if len(diags) > 0 {
+			t.Errorf("%s: schema validation of %s value returned diagnostics, %s values should be skipped: %s", c.name, name, name, diags)
+		}
+
+		if funcErr != nil {
+			t.Errorf("%s: function parameter validation of %s value returned an error, %s values should be skipped: %s", c.name, name, name, funcErr)
+		}
+	}
+
+	// Only the first failure of generated values is reported, as many
+	// values likely fail for the same reason.
+	for _, value := range c.values(t) {
+		diags, funcErr, ok := c.validate(t, value)
+
+		if !ok {
+			return
+		}
+
+		if c.schemaValidate == nil || c.parameterValidate == nil {
+			continue
+		}
+
+		if diags.HasError() != (funcErr != nil) {
+			t.Errorf(
+				"%s: schema and function parameter validation disagree for value %s (seed %d), schema diagnostics: %s, function error: %v",
+				c.name,
+				describeValue(value),
+				c.seed,
+				diags,
+				funcErr,
+			)
+
+			return
+		}
+	}
+}
+
+// validate runs the implemented validators with the value, failing the test
+// and returning false on any panic.
+func (c conformance) validate(t testing.TB, value attr.Value) (diags diag.Diagnostics, funcErr *function.FuncError, ok bool) {
+	t.Helper()
+
+	ok = true
+
+	if c.schemaValidate != nil {
+		if recovered := recoverPanic(func() { diags = c.schemaValidate(c.ctx, c.opts.Request, value) }); recovered != nil {
+			t.Errorf("%s: schema validation of value %s panicked (seed %d): %v", c.name, describeValue(value), c.seed, recovered)
+
+			ok = false
+		}
+	}
+
+	if c.parameterValidate != nil {
+		if recovered := recoverPanic(func() { funcErr = c.parameterValidate(c.ctx, value) }); recovered != nil {
+			t.Errorf("%s: function parameter validation of value %s panicked (seed %d): %v", c.name, describeValue(value), c.seed, recovered)
+
+			ok = false
+		}
+	}
+
+	return diags, funcErr, ok
+}
+
+// values returns the boundary, random, and additional values of the type.
+func (c conformance) values(t testing.TB) []attr.Value {
+	t.Helper()
+
+	generator := newValueGenerator(c.seed)
+	tfType := c.typ.TerraformType(c.ctx)
+	tfValues := generator.boundary(tfType)
+
+	for range c.opts.randomValues() {
+		tfValues = append(tfValues, generator.random(tfType, 0))
+	}
+
+	var values []attr.Value
+
+	for _, tfValue := range tfValues {
+		value, err := c.typ.ValueFromTerraform(c.ctx, tfValue)
+
+		// Generated values may not be valid for the type, such as
+		// fractional numbers for Int64, so they are skipped.
+		if err != nil {
+			continue
+		}
+
+		values = append(values, value)
+	}
+
+	for _, value := range c.opts.Values {
+		if value.Type(c.ctx).Equal(c.typ) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// recoverPanic calls the function and returns the recovered value of any
+// panic.
+func recoverPanic(fn func()) (recovered any) {
+	defer func() {
+		recovered = recover()
+	}()
+
+	fn()
+
+	return nil
+}
+
+// describeValue returns the value for test failure messages.
+func describeValue(value attr.Value) string {
+	described := value.String()
+
+	if len(described) > maxDescribedValueLength {
+		return fmt.Sprintf("%s... (%d bytes)", described[:maxDescribedValueLength], len(described))
+	}
+
+	return described
+}
@@ -0,0 +1,465 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// conformanceKind is a type of value which validators can implement.
+type conformanceKind struct {
+	// name is the name of the type, such as "String".
+	name string
+
+	// typ returns the attr.Type of generated values.
+	typ func(opts ConformanceOptions) attr.Type
+
+	// schema returns the schema validation of the validator, if implemented.
+	schema func(v any) (schemaValidateFunc, bool)
+
+	// parameter returns the function parameter validation of the validator,
+	// if implemented.
+	parameter func(v any) (parameterValidateFunc, bool)
+}
+
+var conformanceKinds = []conformanceKind{
+	{
+		name: "Bool",
+		typ: func(_ ConformanceOptions) attr.Type {
+			return types.BoolType
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Bool)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.BoolResponse{}
+
+				schemaValidator.ValidateBool(ctx, request.Bool(value.(types.Bool)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.BoolParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.BoolParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterBool(ctx, BoolParameterRequest(0, value.(types.Bool)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "Dynamic",
+		typ: func(_ ConformanceOptions) attr.Type {
+			return types.DynamicType
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Dynamic)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.DynamicResponse{}
+
+				schemaValidator.ValidateDynamic(ctx, request.Dynamic(value.(types.Dynamic)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.DynamicParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.DynamicParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterDynamic(ctx, DynamicParameterRequest(0, value.(types.Dynamic)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "Float32",
+		typ: func(_ ConformanceOptions) attr.Type {
+			return types.Float32Type
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Float32)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.Float32Response{}
+
+				schemaValidator.ValidateFloat32(ctx, request.Float32(value.(types.Float32)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.Float32ParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.Float32ParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterFloat32(ctx, Float32ParameterRequest(0, value.(types.Float32)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "Float64",
+		typ: func(_ ConformanceOptions) attr.Type {
+			return types.Float64Type
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Float64)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.Float64Response{}
+
+				schemaValidator.ValidateFloat64(ctx, request.Float64(value.(types.Float64)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.Float64ParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.Float64ParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterFloat64(ctx, Float64ParameterRequest(0, value.(types.Float64)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "Int32",
+		typ: func(_ ConformanceOptions) attr.Type {
+			return types.Int32Type
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Int32)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.Int32Response{}
+
+				schemaValidator.ValidateInt32(ctx, request.Int32(value.(types.Int32)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.Int32ParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.Int32ParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterInt32(ctx, Int32ParameterRequest(0, value.(types.Int32)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "Int64",
+		typ: func(_ ConformanceOptions) attr.Type {
+			return types.Int64Type
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Int64)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.Int64Response{}
+
+				schemaValidator.ValidateInt64(ctx, request.Int64(value.(types.Int64)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.Int64ParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.Int64ParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterInt64(ctx, Int64ParameterRequest(0, value.(types.Int64)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "List",
+		typ: func(opts ConformanceOptions) attr.Type {
+			return types.ListType{ElemType: opts.elementType()}
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.List)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.ListResponse{}
+
+				schemaValidator.ValidateList(ctx, request.List(value.(types.List)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.ListParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.ListParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterList(ctx, ListParameterRequest(0, value.(types.List)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "Map",
+		typ: func(opts ConformanceOptions) attr.Type {
+			return types.MapType{ElemType: opts.elementType()}
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Map)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.MapResponse{}
+
+				schemaValidator.ValidateMap(ctx, request.Map(value.(types.Map)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.MapParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.MapParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterMap(ctx, MapParameterRequest(0, value.(types.Map)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "Number",
+		typ: func(_ ConformanceOptions) attr.Type {
+			return types.NumberType
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Number)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.NumberResponse{}
+
+				schemaValidator.ValidateNumber(ctx, request.Number(value.(types.Number)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.NumberParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.NumberParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterNumber(ctx, NumberParameterRequest(0, value.(types.Number)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "Object",
+		typ: func(opts ConformanceOptions) attr.Type {
+			return types.ObjectType{AttrTypes: opts.attributeTypes()}
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Object)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.ObjectResponse{}
+
+				schemaValidator.ValidateObject(ctx, request.Object(value.(types.Object)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.ObjectParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.ObjectParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterObject(ctx, ObjectParameterRequest(0, value.(types.Object)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "Set",
+		typ: func(opts ConformanceOptions) attr.Type {
+			return types.SetType{ElemType: opts.elementType()}
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.Set)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.SetResponse{}
+
+				schemaValidator.ValidateSet(ctx, request.Set(value.(types.Set)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.SetParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.SetParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterSet(ctx, SetParameterRequest(0, value.(types.Set)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+	{
+		name: "String",
+		typ: func(_ ConformanceOptions) attr.Type {
+			return types.StringType
+		},
+		schema: func(v any) (schemaValidateFunc, bool) {
+			schemaValidator, ok := v.(validator.String)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, request Request, value attr.Value) diag.Diagnostics {
+				response := &validator.StringResponse{}
+
+				schemaValidator.ValidateString(ctx, request.String(value.(types.String)), response)
+
+				return response.Diagnostics
+			}, true
+		},
+		parameter: func(v any) (parameterValidateFunc, bool) {
+			parameterValidator, ok := v.(function.StringParameterValidator)
+
+			if !ok {
+				return nil, false
+			}
+
+			return func(ctx context.Context, value attr.Value) *function.FuncError {
+				response := &function.StringParameterValidatorResponse{}
+
+				parameterValidator.ValidateParameterString(ctx, StringParameterRequest(0, value.(types.String)), response)
+
+				return response.Error
+			}, true
+		},
+	},
+}
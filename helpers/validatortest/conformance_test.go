@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+// nullStringValidator does not skip null values.
+type nullStringValidator struct{}
+
+func (v nullStringValidator) Description(_ context.Context) string {
+	return "value must be configured"
+}
+
+func (v nullStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v nullStringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Missing Value", "value must be configured")
+	}
+}
+
+// firstElementListValidator panics on empty lists.
+type firstElementListValidator struct{}
+
+func (v firstElementListValidator) Description(_ context.Context) string {
+	return "first element must be known"
+}
+
+func (v firstElementListValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v firstElementListValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.Elements()[0].IsUnknown() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Unknown Element", "first element must be known")
+	}
+}
+
+// divergingStringValidator only returns errors for schema validation.
+type divergingStringValidator struct{}
+
+func (v divergingStringValidator) Description(_ context.Context) string {
+	return "value must not be empty"
+}
+
+func (v divergingStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v divergingStringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Empty Value", "value must not be empty")
+	}
+}
+
+func (v divergingStringValidator) ValidateParameterString(_ context.Context, _ function.StringParameterValidatorRequest, _ *function.StringParameterValidatorResponse) {
+}
+
+// emptyDescriptionBoolValidator has no description.
+type emptyDescriptionBoolValidator struct{}
+
+func (v emptyDescriptionBoolValidator) Description(_ context.Context) string {
+	return ""
+}
+
+func (v emptyDescriptionBoolValidator) MarkdownDescription(_ context.Context) string {
+	return ""
+}
+
+func (v emptyDescriptionBoolValidator) ValidateBool(_ context.Context, _ validator.BoolRequest, _ *validator.BoolResponse) {
+}
+
+func TestRunConformance(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator     any
+		expectFailure string
+	}
+	tests := map[string]testCase{
+		"LengthAtLeast": {
+			validator: stringvalidator.LengthAtLeast(3),
+		},
+		"SizeAtLeast": {
+			validator: listvalidator.SizeAtLeast(1),
+		},
+		"null-not-skipped": {
+			validator:     nullStringValidator{},
+			expectFailure: "String: schema validation of null value returned diagnostics",
+		},
+		"panic": {
+			validator:     firstElementListValidator{},
+			expectFailure: "List: schema validation of value [] panicked",
+		},
+		"diverging": {
+			validator:     divergingStringValidator{},
+			expectFailure: `String: schema and function parameter validation disagree for value ""`,
+		},
+		"empty-description": {
+			validator:     emptyDescriptionBoolValidator{},
+			expectFailure: "Description is empty",
+		},
+		"not-a-validator": {
+			validator:     "example",
+			expectFailure: "string does not implement any",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tb := &recordingTB{TB: t}
+
+			validatortest.RunConformance(tb, test.validator)
+
+			got := strings.Join(tb.errors, "\n")
+
+			if test.expectFailure == "" && got != "" {
+				t.Fatalf("got unexpected failure: %s", got)
+			}
+
+			if !strings.Contains(got, test.expectFailure) {
+				t.Fatalf("expected failure containing %q, got: %s", test.expectFailure, got)
+			}
+		})
+	}
+}
+
+// Environment variables cannot be set in parallel tests.
+func TestRunConformance_SeedEnvVar(t *testing.T) {
+	type testCase struct {
+		env           string
+		expectFailure string
+	}
+	tests := map[string]testCase{
+		"default": {
+			expectFailure: "(seed 1)",
+		},
+		"seed": {
+			env:           "42",
+			expectFailure: "(seed 42)",
+		},
+		"random": {
+			env:           "random",
+			expectFailure: "(seed ",
+		},
+		"invalid": {
+			env:           "invalid",
+			expectFailure: `invalid VALIDATORTEST_CONFORMANCE_SEED environment variable value "invalid"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(validatortest.ConformanceSeedEnvVar, test.env)
+
+			tb := &recordingTB{TB: t}
+
+			validatortest.RunConformance(tb, divergingStringValidator{})
+
+			got := strings.Join(tb.errors, "\n")
+
+			if !strings.Contains(got, test.expectFailure) {
+				t.Fatalf("expected failure containing %q, got: %s", test.expectFailure, got)
+			}
+		})
+	}
+}
+
+func TestRunConformanceWithOptions(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunConformanceWithOptions(t, listvalidator.ValueInt64sAre(), validatortest.ConformanceOptions{
+		ElementType:  types.Int64Type,
+		RandomValues: 10,
+		Seed:         1,
+		Values: []attr.Value{
+			types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)}),
+		},
+	})
+}
@@ -0,0 +1,319 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// maxRandomDepth is the maximum nesting depth of randomly generated
+// collection and object values, beyond which they are empty.
+const maxRandomDepth = 3
+
+// maxRandomElements is the maximum number of elements of randomly generated
+// collection values.
+const maxRandomElements = 5
+
+// maxRandomStringLength is the maximum number of characters of randomly
+// generated strings.
+const maxRandomStringLength = 32
+
+// randomStringRunes are the characters of randomly generated strings in
+// addition to printable ASCII characters.
+var randomStringRunes = []rune{'\t', '\n', 'é', 'ß', 'Ж', '日', '本', '🎉'}
+
+// boundaryStrings are strings which commonly reveal validator bugs.
+var boundaryStrings = []string{
+	"",
+	" ",
+	"a",
+	"A",
+	"0",
+	"-1",
+	"1.5",
+	"true",
+	"null",
+	"日本語",
+	"🎉",
+	"line\nbreak",
+	"\x00",
+	strings.Repeat("a", 4096),
+}
+
+// boundaryNumbers are numbers which commonly reveal validator bugs. Numbers
+// which are not valid for a type, such as fractions for Int64, are skipped.
+var boundaryNumbers = []*big.Float{
+	big.NewFloat(0),
+	big.NewFloat(1),
+	big.NewFloat(-1),
+	big.NewFloat(0.5),
+	big.NewFloat(-0.5),
+	big.NewFloat(math.MaxInt32),
+	big.NewFloat(math.MinInt32),
+	new(big.Float).SetInt64(math.MaxInt64),
+	new(big.Float).SetInt64(math.MinInt64),
+	big.NewFloat(math.MaxFloat32),
+	big.NewFloat(-math.MaxFloat32),
+	big.NewFloat(math.MaxFloat64),
+	big.NewFloat(math.SmallestNonzeroFloat64),
+	new(big.Float).SetMantExp(big.NewFloat(1), 2048),
+}
+
+// valueGenerator generates boundary and random values of a type.
+type valueGenerator struct {
+	rand *rand.Rand
+}
+
+func newValueGenerator(seed uint64) valueGenerator {
+	return valueGenerator{
+		rand: rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// boundary returns values of the type which commonly reveal validator bugs,
+// such as empty strings and collections, or collections with null or unknown
+// elements.
+func (g valueGenerator) boundary(typ tftypes.Type) []tftypes.Value {
+	switch {
+	case typ.Is(tftypes.DynamicPseudoType):
+		return slices.Concat(g.boundary(tftypes.String), g.boundary(tftypes.Number), g.boundary(tftypes.Bool))
+	case typ.Is(tftypes.String):
+		values := make([]tftypes.Value, 0, len(boundaryStrings))
+
+		for _, s := range boundaryStrings {
+			values = append(values, tftypes.NewValue(typ, s))
+		}
+
+		return values
+	case typ.Is(tftypes.Number):
+		values := make([]tftypes.Value, 0, len(boundaryNumbers))
+
+		for _, n := range boundaryNumbers {
+			values = append(values, tftypes.NewValue(typ, n))
+		}
+
+		return values
+	case typ.Is(tftypes.Bool):
+		return []tftypes.Value{
+			tftypes.NewValue(typ, false),
+			tftypes.NewValue(typ, true),
+		}
+	case typ.Is(tftypes.List{}):
+		return g.boundaryCollections(typ, typ.(tftypes.List).ElementType)
+	case typ.Is(tftypes.Set{}):
+		return g.boundaryCollections(typ, typ.(tftypes.Set).ElementType)
+	case typ.Is(tftypes.Map{}):
+		return g.boundaryMaps(typ.(tftypes.Map))
+	case typ.Is(tftypes.Object{}):
+		return g.boundaryObjects(typ.(tftypes.Object))
+	default:
+		return nil
+	}
+}
+
+// boundaryCollections returns empty lists or sets, those with a single null,
+// unknown, or boundary element, and one with all boundary elements.
+func (g valueGenerator) boundaryCollections(typ tftypes.Type, elementType tftypes.Type) []tftypes.Value {
+	elementType = concreteType(elementType)
+
+	values := []tftypes.Value{
+		tftypes.NewValue(typ, []tftypes.Value{}),
+		tftypes.NewValue(typ, []tftypes.Value{tftypes.NewValue(elementType, nil)}),
+		tftypes.NewValue(typ, []tftypes.Value{tftypes.NewValue(elementType, tftypes.UnknownValue)}),
+	}
+
+	elements := g.boundary(elementType)
+
+	for _, element := range elements {
+		values = append(values, tftypes.NewValue(typ, []tftypes.Value{element}))
+	}
+
+	if len(elements) > 1 {
+		values = append(values, tftypes.NewValue(typ, elements))
+	}
+
+	return values
+}
+
+// boundaryMaps returns an empty map, maps with a single null, unknown, or
+// boundary element, and one with all boundary elements.
+func (g valueGenerator) boundaryMaps(typ tftypes.Map) []tftypes.Value {
+	elementType := concreteType(typ.ElementType)
+
+	values := []tftypes.Value{
+		tftypes.NewValue(typ, map[string]tftypes.Value{}),
+		tftypes.NewValue(typ, map[string]tftypes.Value{"key": tftypes.NewValue(elementType, nil)}),
+		tftypes.NewValue(typ, map[string]tftypes.Value{"key": tftypes.NewValue(elementType, tftypes.UnknownValue)}),
+	}
+
+	elements := g.boundary(elementType)
+	all := make(map[string]tftypes.Value, len(elements))
+
+	for i, element := range elements {
+		key := boundaryStrings[i%len(boundaryStrings)]
+
+		values = append(values, tftypes.NewValue(typ, map[string]tftypes.Value{key: element}))
+		all[key] = element
+	}
+
+	if len(all) > 1 {
+		values = append(values, tftypes.NewValue(typ, all))
+	}
+
+	return values
+}
+
+// boundaryObjects returns objects with all null attributes, all unknown
+// attributes, and each boundary value of every attribute.
+func (g valueGenerator) boundaryObjects(typ tftypes.Object) []tftypes.Value {
+	nullAttributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	unknownAttributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for name, attributeType := range typ.AttributeTypes {
+		nullAttributes[name] = tftypes.NewValue(attributeType, nil)
+		unknownAttributes[name] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+	}
+
+	values := []tftypes.Value{
+		tftypes.NewValue(typ, nullAttributes),
+		tftypes.NewValue(typ, unknownAttributes),
+	}
+
+	for name, attributeType := range typ.AttributeTypes {
+		for _, attribute := range g.boundary(attributeType) {
+			attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+			for otherName, otherValue := range nullAttributes {
+				attributes[otherName] = otherValue
+			}
+
+			attributes[name] = attribute
+
+			values = append(values, tftypes.NewValue(typ, attributes))
+		}
+	}
+
+	return values
+}
+
+// random returns a random value of the type, with nested values generated
+// up to maxRandomDepth.
+func (g valueGenerator) random(typ tftypes.Type, depth int) tftypes.Value {
+	switch {
+	case typ.Is(tftypes.DynamicPseudoType):
+		return g.random([]tftypes.Type{tftypes.String, tftypes.Number, tftypes.Bool}[g.rand.IntN(3)], depth)
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, g.randomString())
+	case typ.Is(tftypes.Number):
+		return tftypes.NewValue(typ, g.randomNumber())
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, g.rand.IntN(2) == 1)
+	case typ.Is(tftypes.List{}):
+		return tftypes.NewValue(typ, g.randomElements(typ.(tftypes.List).ElementType, depth, false))
+	case typ.Is(tftypes.Set{}):
+		return tftypes.NewValue(typ, g.randomElements(typ.(tftypes.Set).ElementType, depth, true))
+	case typ.Is(tftypes.Map{}):
+		elements := make(map[string]tftypes.Value)
+
+		for _, element := range g.randomElements(typ.(tftypes.Map).ElementType, depth, false) {
+			elements[g.randomString()] = element
+		}
+
+		return tftypes.NewValue(typ, elements)
+	case typ.Is(tftypes.Object{}):
+		attributes := make(map[string]tftypes.Value)
+
+		for name, attributeType := range typ.(tftypes.Object).AttributeTypes {
+			attributes[name] = g.randomElement(attributeType, depth+1)
+		}
+
+		return tftypes.NewValue(typ, attributes)
+	default:
+		return tftypes.NewValue(typ, nil)
+	}
+}
+
+// randomElements returns random collection elements, which are unique if
+// unique is true.
+func (g valueGenerator) randomElements(elementType tftypes.Type, depth int, unique bool) []tftypes.Value {
+	elementType = concreteType(elementType)
+	elements := []tftypes.Value{}
+
+	if depth >= maxRandomDepth {
+		return elements
+	}
+
+	for range g.rand.IntN(maxRandomElements + 1) {
+		element := g.randomElement(elementType, depth+1)
+
+		if unique && slices.ContainsFunc(elements, element.Equal) {
+			continue
+		}
+
+		elements = append(elements, element)
+	}
+
+	return elements
+}
+
+// randomElement returns a random value of the type, which is occasionally
+// null or unknown.
+func (g valueGenerator) randomElement(typ tftypes.Type, depth int) tftypes.Value {
+	switch g.rand.IntN(20) {
+	case 0:
+		return tftypes.NewValue(typ, nil)
+	case 1:
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	default:
+		return g.random(typ, depth)
+	}
+}
+
+// randomString returns a string of random length with mostly printable ASCII
+// characters.
+func (g valueGenerator) randomString() string {
+	var b strings.Builder
+
+	for range g.rand.IntN(maxRandomStringLength + 1) {
+		if g.rand.IntN(10) == 0 {
+			b.WriteRune(randomStringRunes[g.rand.IntN(len(randomStringRunes))])
+
+			continue
+		}
+
+		b.WriteRune(rune(' ' + g.rand.IntN('~'-' '+1)))
+	}
+
+	return b.String()
+}
+
+// randomNumber returns a random small integer, large integer, or fraction.
+func (g valueGenerator) randomNumber() *big.Float {
+	switch g.rand.IntN(4) {
+	case 0:
+		return big.NewFloat(float64(g.rand.IntN(201) - 100))
+	case 1:
+		return new(big.Float).SetInt64(int64(g.rand.Uint64()))
+	case 2:
+		return big.NewFloat(float64(int32(g.rand.Uint32())))
+	default:
+		return big.NewFloat(g.rand.NormFloat64() * math.Pow10(g.rand.IntN(10)))
+	}
+}
+
+// concreteType returns the type of generated collection elements, which must
+// all be the same type for dynamic element types.
+func concreteType(typ tftypes.Type) tftypes.Type {
+	if typ.Is(tftypes.DynamicPseudoType) {
+		return tftypes.String
+	}
+
+	return typ
+}
@@ -0,0 +1,7 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validatortest provides helpers for unit testing validator
+// implementations, including request builders, configuration builders,
+// diagnostic assertions, conformance tests, and fuzz targets.
+package validatortest
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func ExampleStringRequest() {
+	request := validatortest.StringRequest(types.StringValue("ab"))
+	response := validator.StringResponse{}
+
+	stringvalidator.LengthAtLeast(3).ValidateString(context.Background(), request, &response)
+
+	fmt.Println(response.Diagnostics[0].Detail())
+	// Output: Attribute test string length must be at least 3, got: 2
+}
+
+func ExampleNewConfig() {
+	config, err := validatortest.NewConfig(context.Background(), schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{Required: true},
+			"password": schema.StringAttribute{Required: true},
+		},
+	}, map[string]any{
+		"username": "admin",
+		"password": "admin-password",
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	request := validatortest.Request{
+		Path:   path.Root("password"),
+		Config: config,
+	}.String(types.StringValue("admin-password"))
+	response := validator.StringResponse{}
+
+	stringvalidator.PasswordPolicy(stringvalidator.PasswordPolicyOptions{
+		NotContaining: path.Expressions{path.MatchRoot("username")},
+	}).ValidateString(context.Background(), request, &response)
+
+	fmt.Println(response.Diagnostics[0].Detail())
+	// Output: Attribute password value must satisfy the password policy, unmet rules: not containing the value of username, got: (sensitive value)
+}
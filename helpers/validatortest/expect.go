@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// diagnostic is a comparable representation of a diag.Diagnostic, which
+// produces readable differences.
+type diagnostic struct {
+	Severity string
+	Path     string
+	Summary  string
+	Detail   string
+}
+
+// diagnostics converts the diagnostics, optionally only those with the given
+// severity.
+func diagnostics(diags diag.Diagnostics, severity diag.Severity) []diagnostic {
+	var result []diagnostic
+
+	for _, d := range diags {
+		if severity != diag.SeverityInvalid && d.Severity() != severity {
+			continue
+		}
+
+		converted := diagnostic{
+			Severity: d.Severity().String(),
+			Summary:  d.Summary(),
+			Detail:   d.Detail(),
+		}
+
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			converted.Path = withPath.Path().String()
+		}
+
+		result = append(result, converted)
+	}
+
+	return result
+}
+
+// ExpectNoDiagnostics fails the test if there are any diagnostics.
+func ExpectNoDiagnostics(t testing.TB, got diag.Diagnostics) {
+	t.Helper()
+
+	if diff := cmp.Diff([]diagnostic(nil), diagnostics(got, diag.SeverityInvalid)); diff != "" {
+		t.Errorf("expected no diagnostics (-expected +got):\n%s", diff)
+	}
+}
+
+// ExpectError fails the test if there are no error diagnostics. If expected
+// diagnostics are given, the error diagnostics must also equal them, in
+// order, with the differences reported. Warning diagnostics are ignored.
+func ExpectError(t testing.TB, got diag.Diagnostics, expected ...diag.Diagnostic) {
+	t.Helper()
+
+	expectSeverity(t, got, diag.SeverityError, expected)
+}
+
+// ExpectWarning fails the test if there are no warning diagnostics. If
+// expected diagnostics are given, the warning diagnostics must also equal
+// them, in order, with the differences reported. Error diagnostics are
+// ignored.
+func ExpectWarning(t testing.TB, got diag.Diagnostics, expected ...diag.Diagnostic) {
+	t.Helper()
+
+	expectSeverity(t, got, diag.SeverityWarning, expected)
+}
+
+func expectSeverity(t testing.TB, got diag.Diagnostics, severity diag.Severity, expected []diag.Diagnostic) {
+	t.Helper()
+
+	gotDiagnostics := diagnostics(got, severity)
+
+	if len(gotDiagnostics) == 0 {
+		t.Errorf("expected %s diagnostics, got none", severity)
+
+		return
+	}
+
+	if len(expected) == 0 {
+		return
+	}
+
+	if diff := cmp.Diff(diagnostics(expected, diag.SeverityInvalid), gotDiagnostics); diff != "" {
+		t.Errorf("unexpected %s diagnostics difference (-expected +got):\n%s", severity, diff)
+	}
+}
+
+// ExpectNoFuncError fails the test if there is a function error.
+func ExpectNoFuncError(t testing.TB, got *function.FuncError) {
+	t.Helper()
+
+	if got != nil {
+		t.Errorf("expected no function error, got: %s", got)
+	}
+}
+
+// ExpectFuncError fails the test if there is no function error. If an
+// expected function error is given, the function error must also equal it,
+// with the differences reported.
+func ExpectFuncError(t testing.TB, got *function.FuncError, expected *function.FuncError) {
+	t.Helper()
+
+	if got == nil {
+		t.Error("expected function error, got none")
+
+		return
+	}
+
+	if expected == nil {
+		return
+	}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected function error difference (-expected +got):\n%s", diff)
+	}
+}
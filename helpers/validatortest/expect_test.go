@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+)
+
+// recordingTB records test failures instead of failing the test.
+type recordingTB struct {
+	testing.TB
+
+	errors []string
+}
+
+func (tb *recordingTB) Helper() {}
+
+func (tb *recordingTB) Error(args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprint(args...))
+}
+
+func (tb *recordingTB) Errorf(format string, args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+func (tb *recordingTB) Fatalf(format string, args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+func TestExpect(t *testing.T) {
+	t.Parallel()
+
+	errorDiag := diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "error detail")
+	warningDiag := diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning Summary", "warning detail")
+
+	type testCase struct {
+		expect        func(testing.TB)
+		expectFailure string
+	}
+	tests := map[string]testCase{
+		"no-diagnostics-pass": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectNoDiagnostics(tb, nil)
+			},
+		},
+		"no-diagnostics-fail": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectNoDiagnostics(tb, diag.Diagnostics{warningDiag})
+			},
+			expectFailure: "Warning Summary",
+		},
+		"error-pass": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectError(tb, diag.Diagnostics{errorDiag, warningDiag})
+			},
+		},
+		"error-expected-pass": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectError(tb, diag.Diagnostics{errorDiag, warningDiag}, errorDiag)
+			},
+		},
+		"error-fail": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectError(tb, diag.Diagnostics{warningDiag})
+			},
+			expectFailure: "expected Error diagnostics, got none",
+		},
+		"error-expected-fail": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectError(
+					tb,
+					diag.Diagnostics{errorDiag},
+					diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "other detail"),
+				)
+			},
+			expectFailure: "other detail",
+		},
+		"warning-pass": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectWarning(tb, diag.Diagnostics{errorDiag, warningDiag}, warningDiag)
+			},
+		},
+		"warning-fail": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectWarning(tb, diag.Diagnostics{errorDiag})
+			},
+			expectFailure: "expected Warning diagnostics, got none",
+		},
+		"no-func-error-pass": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectNoFuncError(tb, nil)
+			},
+		},
+		"no-func-error-fail": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectNoFuncError(tb, function.NewArgumentFuncError(0, "error"))
+			},
+			expectFailure: "expected no function error",
+		},
+		"func-error-pass": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectFuncError(tb, function.NewArgumentFuncError(0, "error"), function.NewArgumentFuncError(0, "error"))
+			},
+		},
+		"func-error-fail": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectFuncError(tb, nil, nil)
+			},
+			expectFailure: "expected function error, got none",
+		},
+		"func-error-expected-fail": {
+			expect: func(tb testing.TB) {
+				validatortest.ExpectFuncError(tb, function.NewArgumentFuncError(0, "error"), function.NewArgumentFuncError(1, "error"))
+			},
+			expectFailure: "unexpected function error difference",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tb := &recordingTB{TB: t}
+
+			test.expect(tb)
+
+			got := strings.Join(tb.errors, "\n")
+
+			if test.expectFailure == "" && got != "" {
+				t.Fatalf("got unexpected failure: %s", got)
+			}
+
+			if !strings.Contains(got, test.expectFailure) {
+				t.Fatalf("expected failure containing %q, got: %s", test.expectFailure, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxFuzzListElements is the maximum number of elements of fuzzed List
+// values.
+const maxFuzzListElements = 16
+
+// fuzzListSeparator separates the elements of fuzzed List values in the
+// fuzzed string.
+const fuzzListSeparator = "\x00"
+
+// FuzzString fuzzes the validator, which must implement validator.String or
+// function.StringParameterValidator, with String values. Refer to fuzz for the
+// seed corpus and the checked properties.
+func FuzzString(f *testing.F, v any) {
+	f.Helper()
+
+	fz := newFuzzer(f, v, "String")
+
+	for _, seed := range fz.stringSeeds() {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		fz.check(t, types.StringValue(value))
+	})
+}
+
+// FuzzInt64 fuzzes the validator, which must implement validator.Int64 or
+// function.Int64ParameterValidator, with Int64 values. Refer to fuzz for the
+// seed corpus and the checked properties.
+func FuzzInt64(f *testing.F, v any) {
+	f.Helper()
+
+	fz := newFuzzer(f, v, "Int64")
+
+	for _, seed := range fz.int64Seeds() {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, value int64) {
+		fz.check(t, types.Int64Value(value))
+	})
+}
+
+// FuzzList fuzzes the validator, which must implement validator.List or
+// function.ListParameterValidator, with List values of String elements.
+// Refer to fuzz for the seed corpus and the checked properties.
+//
+// Each fuzzed List is built from a size of up to 16 elements, the
+// NUL-separated element strings, and bit masks of the elements which are
+// null or unknown, so the fuzzing engine can mutate each independently.
+func FuzzList(f *testing.F, v any) {
+	f.Helper()
+
+	fz := newFuzzer(f, v, "List")
+
+	for _, seed := range fz.listSeeds() {
+		f.Add(seed.size, seed.elements, seed.nulls, seed.unknowns)
+	}
+
+	f.Fuzz(func(t *testing.T, size uint8, elements string, nulls uint16, unknowns uint16) {
+		fz.check(t, fuzzListValue(size, elements, nulls, unknowns))
+	})
+}
+
+// fuzzer runs a validator with fuzzed values of a single type.
+//
+// The seed corpus contains boundary values, such as empty strings and
+// collections or the minimum and maximum integers, and values derived from
+// the constraints of the validator: values quoted or numbers mentioned in
+// its Description, and the values on both sides of each boundary between
+// valid and invalid lengths, sizes, or integers found by probing the
+// validator.
+//
+// Each fuzzed value must not cause a panic, and validating the same value
+// again must return the same diagnostics and function error.
+type fuzzer struct {
+	ctx               context.Context
+	name              string
+	description       string
+	schemaValidate    schemaValidateFunc
+	parameterValidate parameterValidateFunc
+}
+
+// newFuzzer returns a fuzzer of the validator with the named type, or fails
+// the test if the validator implements neither interface of the type.
+func newFuzzer(t testing.TB, v any, name string) fuzzer {
+	t.Helper()
+
+	fz := fuzzer{
+		ctx:  context.Background(),
+		name: name,
+	}
+
+	if describer, ok := v.(validator.Describer); ok {
+		fz.description = describer.Description(fz.ctx)
+	}
+
+	for _, kind := range conformanceKinds {
+		if kind.name != name {
+			continue
+		}
+
+		fz.schemaValidate, _ = kind.schema(v)
+		fz.parameterValidate, _ = kind.parameter(v)
+	}
+
+	if fz.schemaValidate == nil && fz.parameterValidate == nil {
+		t.Fatalf("%T does not implement validator.%s or function.%sParameterValidator", v, name, name)
+	}
+
+	return fz
+}
+
+// check validates the value twice, failing the test on any panic or
+// difference between the results.
+func (fz fuzzer) check(t testing.TB, value attr.Value) {
+	t.Helper()
+
+	diags, funcErr, ok := fz.validate(t, value)
+
+	if !ok {
+		return
+	}
+
+	repeatedDiags, repeatedFuncErr, ok := fz.validate(t, value)
+
+	if !ok {
+		return
+	}
+
+	if diff := cmp.Diff(diagnostics(diags, diag.SeverityInvalid), diagnostics(repeatedDiags, diag.SeverityInvalid)); diff != "" {
+		t.Errorf("%s: schema validation of value %s is not deterministic (-first +second):\n%s", fz.name, describeValue(value), diff)
+	}
+
+	if diff := cmp.Diff(funcErr, repeatedFuncErr); diff != "" {
+		t.Errorf("%s: function parameter validation of value %s is not deterministic (-first +second):\n%s", fz.name, describeValue(value), diff)
+	}
+}
+
+// validate runs the implemented validators with the value, failing the test
+// and returning false on any panic.
+func (fz fuzzer) validate(t testing.TB, value attr.Value) (diags diag.Diagnostics, funcErr *function.FuncError, ok bool) {
+	t.Helper()
+
+	ok = true
+
+	if fz.schemaValidate != nil {
+		if recovered := recoverPanic(func() { diags = fz.schemaValidate(fz.ctx, Request{}, value) }); recovered != nil {
+			t.Errorf("%s: schema validation of value %s panicked: %v", fz.name, describeValue(value), recovered)
+
+			ok = false
+		}
+	}
+
+	if fz.parameterValidate != nil {
+		if recovered := recoverPanic(func() { funcErr = fz.parameterValidate(fz.ctx, value) }); recovered != nil {
+			t.Errorf("%s: function parameter validation of value %s panicked: %v", fz.name, describeValue(value), recovered)
+
+			ok = false
+		}
+	}
+
+	return diags, funcErr, ok
+}
+
+// valid returns whether the implemented validators return no error for the
+// value. Panics are treated as errors, as they are reported when fuzzing.
+func (fz fuzzer) valid(value attr.Value) bool {
+	var diags diag.Diagnostics
+	var funcErr *function.FuncError
+
+	recovered := recoverPanic(func() {
+		if fz.schemaValidate != nil {
+			diags = fz.schemaValidate(fz.ctx, Request{}, value)
+		}
+
+		if fz.parameterValidate != nil {
+			funcErr = fz.parameterValidate(fz.ctx, value)
+		}
+	})
+
+	return recovered == nil && !diags.HasError() && funcErr == nil
+}
+
+// fuzzListValue returns the List value of the fuzzed inputs of FuzzList.
+func fuzzListValue(size uint8, elements string, nulls uint16, unknowns uint16) types.List {
+	count := int(size) % (maxFuzzListElements + 1)
+	values := make([]attr.Value, 0, count)
+	parts := strings.SplitN(elements, fuzzListSeparator, max(count, 1))
+
+	for i := range count {
+		switch {
+		case nulls&(1<<i) != 0:
+			values = append(values, types.StringNull())
+		case unknowns&(1<<i) != 0:
+			values = append(values, types.StringUnknown())
+		case i < len(parts):
+			values = append(values, types.StringValue(parts[i]))
+		default:
+			values = append(values, types.StringValue(""))
+		}
+	}
+
+	return types.ListValueMust(types.StringType, values)
+}
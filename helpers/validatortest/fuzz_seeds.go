@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxProbeStringLength is the maximum string length probed for boundaries
+// between valid and invalid lengths.
+const maxProbeStringLength = 256
+
+// describedStringRegex matches Go quoted strings in descriptions, such as
+// the values of OneOf.
+var describedStringRegex = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+// describedIntegerRegex matches integers in descriptions, such as the bounds
+// of Between.
+var describedIntegerRegex = regexp.MustCompile(`-?\d+`)
+
+// boundaryInt64s are integers which commonly reveal validator bugs.
+var boundaryInt64s = []int64{
+	0,
+	1,
+	-1,
+	math.MaxInt32,
+	math.MinInt32,
+	math.MaxInt64,
+	math.MinInt64,
+}
+
+// listSeed is a seed of the fuzzed inputs of FuzzList.
+type listSeed struct {
+	size     uint8
+	elements string
+	nulls    uint16
+	unknowns uint16
+}
+
+// describedStrings returns the quoted strings in the description.
+func (fz fuzzer) describedStrings() []string {
+	var result []string
+
+	for _, quoted := range describedStringRegex.FindAllString(fz.description, -1) {
+		s, err := strconv.Unquote(quoted)
+
+		if err != nil {
+			continue
+		}
+
+		result = append(result, s)
+	}
+
+	return result
+}
+
+// describedInt64s returns the integers in the description and their
+// neighbours.
+func (fz fuzzer) describedInt64s() []int64 {
+	var result []int64
+
+	for _, match := range describedIntegerRegex.FindAllString(fz.description, -1) {
+		n, err := strconv.ParseInt(match, 10, 64)
+
+		if err != nil {
+			continue
+		}
+
+		result = append(result, n)
+
+		if n > math.MinInt64 {
+			result = append(result, n-1)
+		}
+
+		if n < math.MaxInt64 {
+			result = append(result, n+1)
+		}
+	}
+
+	return result
+}
+
+// stringSeeds returns the boundary strings, the strings and integers in the
+// description, and the strings on both sides of each boundary between valid
+// and invalid lengths.
+func (fz fuzzer) stringSeeds() []string {
+	seeds := slices.Clone(boundaryStrings)
+	seeds = append(seeds, fz.describedStrings()...)
+
+	for _, n := range fz.describedInt64s() {
+		seeds = append(seeds, strconv.FormatInt(n, 10))
+	}
+
+	// Both single and multiple byte characters are probed, as validators
+	// can count either bytes or characters.
+	for _, character := range []string{"a", "é"} {
+		previous := fz.valid(types.StringValue(""))
+
+		for length := 1; length <= maxProbeStringLength; length++ {
+			current := fz.valid(types.StringValue(strings.Repeat(character, length)))
+
+			if current != previous {
+				seeds = append(seeds, strings.Repeat(character, length-1), strings.Repeat(character, length))
+			}
+
+			previous = current
+		}
+	}
+
+	return compactSeeds(seeds)
+}
+
+// int64Seeds returns the boundary integers, the integers in the description
+// and their neighbours, and the integers on both sides of each boundary
+// between valid and invalid values.
+func (fz fuzzer) int64Seeds() []int64 {
+	probes := slices.Concat(boundaryInt64s, fz.describedInt64s())
+
+	slices.Sort(probes)
+	probes = slices.Compact(probes)
+
+	seeds := slices.Clone(probes)
+
+	for i := 1; i < len(probes); i++ {
+		low, high := probes[i-1], probes[i]
+		lowValid := fz.valid(types.Int64Value(low))
+
+		if lowValid == fz.valid(types.Int64Value(high)) {
+			continue
+		}
+
+		// Binary search for the adjacent integers with differing validity,
+		// with the midpoint calculated without overflowing.
+		for high-low > 1 {
+			mid := low + int64((uint64(high)-uint64(low))/2)
+
+			if fz.valid(types.Int64Value(mid)) == lowValid {
+				low = mid
+			} else {
+				high = mid
+			}
+		}
+
+		seeds = append(seeds, low, high)
+	}
+
+	return compactSeeds(seeds)
+}
+
+// listSeeds returns boundary lists, such as empty lists and lists with null,
+// unknown, or duplicate elements, lists of the strings in the description,
+// and the lists on both sides of each boundary between valid and invalid
+// sizes.
+func (fz fuzzer) listSeeds() []listSeed {
+	seeds := []listSeed{
+		{size: 0},
+		{size: 1, nulls: 1},
+		{size: 1, unknowns: 1},
+		{size: 2, elements: "a" + fuzzListSeparator + "a"},
+		{size: 3, elements: "a" + fuzzListSeparator + fuzzListSeparator + "b", nulls: 2, unknowns: 4},
+	}
+
+	for _, s := range slices.Concat(boundaryStrings, fz.describedStrings()) {
+		seeds = append(seeds, listSeed{size: 1, elements: s})
+	}
+
+	if described := fz.describedStrings(); len(described) > 0 {
+		described = described[:min(len(described), maxFuzzListElements)]
+
+		seeds = append(seeds, listSeed{
+			size:     uint8(len(described)),
+			elements: strings.Join(described, fuzzListSeparator),
+		})
+	}
+
+	// Elements are unique, so only the size determines validity of size
+	// validators.
+	sizedSeed := func(size int) listSeed {
+		elements := make([]string, size)
+
+		for i := range elements {
+			elements[i] = strings.Repeat("a", i+1)
+		}
+
+		return listSeed{
+			size:     uint8(size),
+			elements: strings.Join(elements, fuzzListSeparator),
+		}
+	}
+
+	valid := func(seed listSeed) bool {
+		return fz.valid(fuzzListValue(seed.size, seed.elements, seed.nulls, seed.unknowns))
+	}
+
+	previous := valid(sizedSeed(0))
+
+	for size := 1; size <= maxFuzzListElements; size++ {
+		current := valid(sizedSeed(size))
+
+		if current != previous {
+			seeds = append(seeds, sizedSeed(size-1), sizedSeed(size))
+		}
+
+		previous = current
+	}
+
+	return compactSeeds(seeds)
+}
+
+// compactSeeds returns the seeds without duplicates, in their original order.
+func compactSeeds[T comparable](seeds []T) []T {
+	seen := make(map[T]bool, len(seeds))
+	result := make([]T, 0, len(seeds))
+
+	for _, seed := range seeds {
+		if seen[seed] {
+			continue
+		}
+
+		seen[seed] = true
+
+		result = append(result, seed)
+	}
+
+	return result
+}
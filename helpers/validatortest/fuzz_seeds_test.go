@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestFuzzerStringSeeds(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  []string
+	}{
+		"LengthBetween": {
+			validator: stringvalidator.LengthBetween(3, 5),
+			expected:  []string{"aa", "aaa", "aaaaa", "aaaaaa", "2", "6"},
+		},
+		"UTF8LengthAtLeast": {
+			validator: stringvalidator.UTF8LengthAtLeast(2),
+			expected:  []string{"é", "éé"},
+		},
+		"OneOf": {
+			validator: stringvalidator.OneOf("alpha", "beta"),
+			expected:  []string{"alpha", "beta"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			seeds := newFuzzer(t, testCase.validator, "String").stringSeeds()
+
+			for _, expected := range testCase.expected {
+				if !slices.Contains(seeds, expected) {
+					t.Errorf("expected seed %q, got: %q", expected, seeds)
+				}
+			}
+		})
+	}
+}
+
+func TestFuzzerInt64Seeds(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  []int64
+	}{
+		"Between": {
+			validator: int64validator.Between(10, 20),
+			expected:  []int64{9, 10, 20, 21, math.MinInt64, math.MaxInt64},
+		},
+		"Any": {
+			validator: int64validator.Any(int64validator.AtLeast(1000), int64validator.AtMost(-1000)),
+			expected:  []int64{-1000, -999, 999, 1000},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			seeds := newFuzzer(t, testCase.validator, "Int64").int64Seeds()
+
+			for _, expected := range testCase.expected {
+				if !slices.Contains(seeds, expected) {
+					t.Errorf("expected seed %d, got: %d", expected, seeds)
+				}
+			}
+		})
+	}
+}
+
+func TestFuzzerInt64SeedsProbing(t *testing.T) {
+	t.Parallel()
+
+	fz := newFuzzer(t, int64validator.Between(-12345, 67890), "Int64")
+
+	// Without the description, the bounds are only found by probing.
+	fz.description = ""
+
+	seeds := fz.int64Seeds()
+
+	for _, expected := range []int64{-12346, -12345, 67890, 67891} {
+		if !slices.Contains(seeds, expected) {
+			t.Errorf("expected seed %d, got: %d", expected, seeds)
+		}
+	}
+}
+
+func TestFuzzerListSeeds(t *testing.T) {
+	t.Parallel()
+
+	seeds := newFuzzer(t, listvalidator.SizeBetween(2, 3), "List").listSeeds()
+
+	var sizes []int
+
+	for _, seed := range seeds {
+		value := fuzzListValue(seed.size, seed.elements, seed.nulls, seed.unknowns)
+
+		if len(value.Elements()) > 1 && !value.Elements()[0].Equal(types.StringValue("a")) {
+			continue
+		}
+
+		sizes = append(sizes, len(value.Elements()))
+	}
+
+	for _, expected := range []int{0, 1, 2, 3, 4} {
+		if !slices.Contains(sizes, expected) {
+			t.Errorf("expected seed of size %d, got sizes: %d", expected, sizes)
+		}
+	}
+}
+
+func TestFuzzListValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		size     uint8
+		elements string
+		nulls    uint16
+		unknowns uint16
+		expected types.List
+	}{
+		"empty": {
+			expected: types.ListValueMust(types.StringType, nil),
+		},
+		"size-wraps": {
+			size:     maxFuzzListElements + 2,
+			elements: "a",
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+		},
+		"elements": {
+			size:     3,
+			elements: strings.Join([]string{"a", "b", "c\x00d"}, fuzzListSeparator),
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("a"),
+				types.StringValue("b"),
+				types.StringValue("c\x00d"),
+			}),
+		},
+		"missing-elements": {
+			size:     2,
+			elements: "a",
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("a"),
+				types.StringValue(""),
+			}),
+		},
+		"null-and-unknown": {
+			size:     3,
+			elements: "a\x00b\x00c",
+			nulls:    1,
+			unknowns: 5,
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringNull(),
+				types.StringValue("b"),
+				types.StringUnknown(),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fuzzListValue(testCase.size, testCase.elements, testCase.nulls, testCase.unknowns)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultAttributeName is the root attribute name of requests which do not
+// specify a path.
+const DefaultAttributeName = "test"
+
+// Request builds schema validator requests for an attribute. The zero value
+// builds requests for the root attribute named DefaultAttributeName with an
+// empty configuration.
+type Request struct {
+	// Path is the path of the attribute. Defaults to the root attribute
+	// named DefaultAttributeName.
+	Path path.Path
+
+	// Config is the entire configuration, such as one built with the Config
+	// function, which is required by validators that reference other
+	// attributes.
+	Config tfsdk.Config
+}
+
+// path returns the path of the attribute.
+func (r Request) path() path.Path {
+	if len(r.Path.Steps()) == 0 {
+		return path.Root(DefaultAttributeName)
+	}
+
+	return r.Path
+}
+
+// Bool returns a validator.BoolRequest for the attribute with the given value.
+func (r Request) Bool(value types.Bool) validator.BoolRequest {
+	return validator.BoolRequest{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// Dynamic returns a validator.DynamicRequest for the attribute with the given value.
+func (r Request) Dynamic(value types.Dynamic) validator.DynamicRequest {
+	return validator.DynamicRequest{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// Float32 returns a validator.Float32Request for the attribute with the given value.
+func (r Request) Float32(value types.Float32) validator.Float32Request {
+	return validator.Float32Request{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// Float64 returns a validator.Float64Request for the attribute with the given value.
+func (r Request) Float64(value types.Float64) validator.Float64Request {
+	return validator.Float64Request{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// Int32 returns a validator.Int32Request for the attribute with the given value.
+func (r Request) Int32(value types.Int32) validator.Int32Request {
+	return validator.Int32Request{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// Int64 returns a validator.Int64Request for the attribute with the given value.
+func (r Request) Int64(value types.Int64) validator.Int64Request {
+	return validator.Int64Request{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// List returns a validator.ListRequest for the attribute with the given value.
+func (r Request) List(value types.List) validator.ListRequest {
+	return validator.ListRequest{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// Map returns a validator.MapRequest for the attribute with the given value.
+func (r Request) Map(value types.Map) validator.MapRequest {
+	return validator.MapRequest{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// Number returns a validator.NumberRequest for the attribute with the given value.
+func (r Request) Number(value types.Number) validator.NumberRequest {
+	return validator.NumberRequest{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// Object returns a validator.ObjectRequest for the attribute with the given value.
+func (r Request) Object(value types.Object) validator.ObjectRequest {
+	return validator.ObjectRequest{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// Set returns a validator.SetRequest for the attribute with the given value.
+func (r Request) Set(value types.Set) validator.SetRequest {
+	return validator.SetRequest{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// String returns a validator.StringRequest for the attribute with the given value.
+func (r Request) String(value types.String) validator.StringRequest {
+	return validator.StringRequest{
+		Path:           r.path(),
+		PathExpression: r.path().Expression(),
+		Config:         r.Config,
+		ConfigValue:    value,
+	}
+}
+
+// BoolRequest returns a validator.BoolRequest for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func BoolRequest(value types.Bool) validator.BoolRequest {
+	return Request{}.Bool(value)
+}
+
+// DynamicRequest returns a validator.DynamicRequest for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func DynamicRequest(value types.Dynamic) validator.DynamicRequest {
+	return Request{}.Dynamic(value)
+}
+
+// Float32Request returns a validator.Float32Request for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func Float32Request(value types.Float32) validator.Float32Request {
+	return Request{}.Float32(value)
+}
+
+// Float64Request returns a validator.Float64Request for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func Float64Request(value types.Float64) validator.Float64Request {
+	return Request{}.Float64(value)
+}
+
+// Int32Request returns a validator.Int32Request for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func Int32Request(value types.Int32) validator.Int32Request {
+	return Request{}.Int32(value)
+}
+
+// Int64Request returns a validator.Int64Request for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func Int64Request(value types.Int64) validator.Int64Request {
+	return Request{}.Int64(value)
+}
+
+// ListRequest returns a validator.ListRequest for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func ListRequest(value types.List) validator.ListRequest {
+	return Request{}.List(value)
+}
+
+// MapRequest returns a validator.MapRequest for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func MapRequest(value types.Map) validator.MapRequest {
+	return Request{}.Map(value)
+}
+
+// NumberRequest returns a validator.NumberRequest for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func NumberRequest(value types.Number) validator.NumberRequest {
+	return Request{}.Number(value)
+}
+
+// ObjectRequest returns a validator.ObjectRequest for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func ObjectRequest(value types.Object) validator.ObjectRequest {
+	return Request{}.Object(value)
+}
+
+// SetRequest returns a validator.SetRequest for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func SetRequest(value types.Set) validator.SetRequest {
+	return Request{}.Set(value)
+}
+
+// StringRequest returns a validator.StringRequest for the root attribute named
+// DefaultAttributeName with the given value and an empty configuration.
+func StringRequest(value types.String) validator.StringRequest {
+	return Request{}.String(value)
+}
+
+// BoolParameterRequest returns a function.BoolParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func BoolParameterRequest(argumentPosition int64, value types.Bool) function.BoolParameterValidatorRequest {
+	return function.BoolParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// DynamicParameterRequest returns a function.DynamicParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func DynamicParameterRequest(argumentPosition int64, value types.Dynamic) function.DynamicParameterValidatorRequest {
+	return function.DynamicParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// Float32ParameterRequest returns a function.Float32ParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func Float32ParameterRequest(argumentPosition int64, value types.Float32) function.Float32ParameterValidatorRequest {
+	return function.Float32ParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// Float64ParameterRequest returns a function.Float64ParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func Float64ParameterRequest(argumentPosition int64, value types.Float64) function.Float64ParameterValidatorRequest {
+	return function.Float64ParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// Int32ParameterRequest returns a function.Int32ParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func Int32ParameterRequest(argumentPosition int64, value types.Int32) function.Int32ParameterValidatorRequest {
+	return function.Int32ParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// Int64ParameterRequest returns a function.Int64ParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func Int64ParameterRequest(argumentPosition int64, value types.Int64) function.Int64ParameterValidatorRequest {
+	return function.Int64ParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// ListParameterRequest returns a function.ListParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func ListParameterRequest(argumentPosition int64, value types.List) function.ListParameterValidatorRequest {
+	return function.ListParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// MapParameterRequest returns a function.MapParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func MapParameterRequest(argumentPosition int64, value types.Map) function.MapParameterValidatorRequest {
+	return function.MapParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// NumberParameterRequest returns a function.NumberParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func NumberParameterRequest(argumentPosition int64, value types.Number) function.NumberParameterValidatorRequest {
+	return function.NumberParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// ObjectParameterRequest returns a function.ObjectParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func ObjectParameterRequest(argumentPosition int64, value types.Object) function.ObjectParameterValidatorRequest {
+	return function.ObjectParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// SetParameterRequest returns a function.SetParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func SetParameterRequest(argumentPosition int64, value types.Set) function.SetParameterValidatorRequest {
+	return function.SetParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
+
+// StringParameterRequest returns a function.StringParameterValidatorRequest for the
+// argument at the given zero-based position with the given value.
+func StringParameterRequest(argumentPosition int64, value types.String) function.StringParameterValidatorRequest {
+	return function.StringParameterValidatorRequest{
+		ArgumentPosition: argumentPosition,
+		Value:            value,
+	}
+}
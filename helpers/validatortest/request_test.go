@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validatortest_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+)
+
+func TestStringRequest(t *testing.T) {
+	t.Parallel()
+
+	got := validatortest.StringRequest(types.StringValue("example"))
+	expected := validator.StringRequest{
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+		ConfigValue:    types.StringValue("example"),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestRequestInt64(t *testing.T) {
+	t.Parallel()
+
+	request := validatortest.Request{
+		Path: path.Root("parent").AtListIndex(0).AtName("child"),
+	}
+
+	got := request.Int64(types.Int64Value(1))
+	expected := validator.Int64Request{
+		Path:           path.Root("parent").AtListIndex(0).AtName("child"),
+		PathExpression: path.MatchRoot("parent").AtListIndex(0).AtName("child"),
+		ConfigValue:    types.Int64Value(1),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestListParameterRequest(t *testing.T) {
+	t.Parallel()
+
+	value := types.ListNull(types.StringType)
+
+	got := validatortest.ListParameterRequest(1, value)
+	expected := function.ListParameterValidatorRequest{
+		ArgumentPosition: 1,
+		Value:            value,
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -8,16 +8,19 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.Int32 = atMostSumOfValidator{}
+var _ validatorspec.ValidatorWithConstraint = atMostSumOfValidator{}
 
 // atMostSumOfValidator validates that an integer Attribute's value is at most the sum of one
 // or more integer Attributes retrieved via the given path expressions.
@@ -37,7 +40,15 @@ func (av atMostSumOfValidator) Description(_ context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (av atMostSumOfValidator) MarkdownDescription(ctx context.Context) string {
-	return av.Description(ctx)
+	return validatormarkdown.Describe(ctx, av)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (av atMostSumOfValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindAtMostSumOf,
+		Paths: av.attributesToSumPathExpressions,
+	}
 }
 
 // ValidateInt32 performs the validation.
@@ -52,7 +63,7 @@ func (av atMostSumOfValidator) ValidateInt32(ctx context.Context, request valida
 	// Sum the value of all the attributes involved, but only if they are all known.
 	var sumOfAttribs int32
 	for _, expression := range expressions {
-		matchedPaths, diags := request.Config.PathMatches(ctx, expression)
+		matchedPaths, diags := validatorcache.PathMatches(ctx, request.Config, expression)
 		response.Diagnostics.Append(diags...)
 
 		// Collect all errors
@@ -68,8 +79,7 @@ func (av atMostSumOfValidator) ValidateInt32(ctx context.Context, request valida
 			}
 
 			// Get the value
-			var matchedValue attr.Value
-			diags := request.Config.GetAttribute(ctx, mp, &matchedValue)
+			matchedValue, diags := validatorcache.GetAttribute(ctx, request.Config, mp)
 			response.Diagnostics.Append(diags...)
 			if diags.HasError() {
 				continue
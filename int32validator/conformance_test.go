@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int32validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Int32{
+		"AtLeast":            int32validator.AtLeast(1),
+		"AtMost":             int32validator.AtMost(1),
+		"Between":            int32validator.Between(-1, 1),
+		"OneOf":              int32validator.OneOf(1, 2),
+		"NoneOf":             int32validator.NoneOf(1, 2),
+		"All":                int32validator.All(int32validator.AtLeast(1), int32validator.AtMost(2)),
+		"Any":                int32validator.Any(int32validator.AtLeast(2), int32validator.AtMost(-2)),
+		"AnyWithAllWarnings": int32validator.AnyWithAllWarnings(int32validator.AtLeast(2), int32validator.AtMost(-2)),
+		"AsWarning":          int32validator.AsWarning(int32validator.AtLeast(1)),
+		"AnyBestMatch":       int32validator.AnyBestMatch(int32validator.AtLeast(2), int32validator.AtMost(-2)),
+		"Sequence":           int32validator.Sequence(int32validator.AtLeast(1)),
+		"Sensitive":          int32validator.Sensitive(int32validator.AtLeast(1)),
+		"WithMessage":        int32validator.WithMessage(int32validator.AtLeast(1), "Summary", "{{.Detail}}"),
+		"DeprecatedValues":   int32validator.DeprecatedValues(map[int32]string{1: "use 2 instead"}),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int32validator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/deprecation"
+)
+
+// DeprecationDeadline configures when the DeprecatedValuesUntil validator
+// returns errors, rather than warnings, for deprecated values. The deadline
+// has passed once either the Date or the Version condition is met.
+type DeprecationDeadline struct {
+	// Date is the time from which deprecated values are errors. A zero
+	// Date disables this condition.
+	Date time.Time
+
+	// Version is the provider semantic version, such as "6.0.0", from which
+	// deprecated values are errors. An empty Version disables this
+	// condition.
+	Version string
+
+	// ProviderVersion is the semantic version of the running provider,
+	// which is compared to Version. Versions which are not semantic
+	// versions, such as "dev", never meet the Version condition.
+	ProviderVersion string
+}
+
+var _ validator.Int32 = deprecatedValuesValidator{}
+var _ validatorspec.ValidatorWithConstraint = deprecatedValuesValidator{}
+
+type deprecatedValuesValidator struct {
+	values map[int32]string
+
+	// deadline is nil if deprecated values are always warnings.
+	deadline *deprecation.Deadline
+}
+
+func (v deprecatedValuesValidator) Description(_ context.Context) string {
+	values := slices.Sorted(maps.Keys(v.values))
+
+	if v.deadline == nil {
+		return fmt.Sprintf("value should not be one of the deprecated values: %d", values)
+	}
+
+	return fmt.Sprintf("value should not be one of the deprecated values: %d, which are unsupported from %s", values, v.deadline)
+}
+
+func (v deprecatedValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v deprecatedValuesValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	values := make([]types.Int32, 0, len(v.values))
+
+	for _, value := range slices.Sorted(maps.Keys(v.values)) {
+		values = append(values, types.Int32Value(value))
+	}
+
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindDeprecatedValues,
+		Values: validatorspec.Values(values),
+	}
+}
+
+func (v deprecatedValuesValidator) ValidateInt32(ctx context.Context, request validator.Int32Request, response *validator.Int32Response) {
+	// Return an error if the validator has been created in an invalid state
+	if v.deadline != nil {
+		if err := v.deadline.Validate(); err != nil {
+			response.Diagnostics.Append(
+				validatordiag.InvalidValidatorUsageDiagnostic(
+					request.Path,
+					"DeprecatedValuesUntil",
+					err.Error(),
+				),
+			)
+
+			return
+		}
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	message, ok := v.values[request.ConfigValue.ValueInt32()]
+
+	if !ok {
+		return
+	}
+
+	if v.deadline != nil && v.deadline.Passed(time.Now()) {
+		response.Diagnostics.Append(validatordiag.RemovedAttributeValueDiagnostic(
+			request.Path,
+			request.ConfigValue.String(),
+			message,
+		))
+
+		return
+	}
+
+	response.Diagnostics.Append(validatordiag.DeprecatedAttributeValueDiagnostic(
+		request.Path,
+		request.ConfigValue.String(),
+		message,
+	))
+}
+
+// DeprecatedValues returns a validator which returns a warning diagnostic when
+// the Int32 held in the attribute is one of the keys of `values`. The
+// corresponding message is included in the warning and should describe the
+// replacement, such as "use 2 instead". Deprecated values are
+// otherwise accepted. Null (unconfigured) and unknown (known after apply)
+// values are skipped.
+//
+// This validator is not available for function parameters, which cannot
+// return warnings.
+func DeprecatedValues(values map[int32]string) deprecatedValuesValidator {
+	return deprecatedValuesValidator{
+		values: values,
+	}
+}
+
+// DeprecatedValuesUntil returns a validator which is equivalent to
+// DeprecatedValues until the given `deadline` has passed, after which an
+// error diagnostic is returned for deprecated values instead.
+//
+// A `deadline` without a Date or Version, or with a Version which is not a
+// semantic version, will result in an implementation error message during
+// validation.
+func DeprecatedValuesUntil(values map[int32]string, deadline DeprecationDeadline) deprecatedValuesValidator {
+	return deprecatedValuesValidator{
+		values: values,
+		deadline: &deprecation.Deadline{
+			Date:            deadline.Date,
+			Version:         deadline.Version,
+			ProviderVersion: deadline.ProviderVersion,
+		},
+	}
+}
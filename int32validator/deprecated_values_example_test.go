@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int32validator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleDeprecatedValues() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					// Warn when the deprecated value 1 is configured
+					int32validator.DeprecatedValues(map[int32]string{
+						1: "use 2 instead",
+					}),
+				},
+			},
+		},
+	}
+}
@@ -13,21 +13,31 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.Int32 = noneOfValidator{}
 var _ function.Int32ParameterValidator = noneOfValidator{}
+var _ validatorspec.ValidatorWithConstraint = noneOfValidator{}
 
 type noneOfValidator struct {
 	values []types.Int32
 }
 
-func (v noneOfValidator) Description(ctx context.Context) string {
-	return v.MarkdownDescription(ctx)
+func (v noneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: %q", v.values)
 }
 
-func (v noneOfValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("value must be none of: %q", v.values)
+func (v noneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v noneOfValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindNoneOf,
+		Values: validatorspec.Values(v.values),
+	}
 }
 
 func (v noneOfValidator) ValidateInt32(ctx context.Context, request validator.Int32Request, response *validator.Int32Response) {
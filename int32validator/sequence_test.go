@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int32validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestSequenceValidatorValidateInt32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.Int32
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {},
+		"warnings": {
+			validators: []validator.Int32{
+				testvalidator.WarningInt32("Warning 1", "warning 1 detail"),
+				testvalidator.WarningInt32("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewWarningDiagnostic("Warning 2", "warning 2 detail"),
+			},
+		},
+		"error-stops": {
+			validators: []validator.Int32{
+				testvalidator.WarningInt32("Warning 1", "warning 1 detail"),
+				testvalidator.ErrorInt32("Error 1", "error 1 detail"),
+				testvalidator.ErrorInt32("Error 2", "error 2 detail"),
+				testvalidator.WarningInt32("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error 1", "error 1 detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Int32Request{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.Int32Response{}
+
+			int32validator.Sequence(testCase.validators...).ValidateInt32(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSequenceValidatorValidateParameterInt32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []validator.Int32
+		expected   *function.FuncError
+	}{
+		"no-validators": {},
+		"error-stops": {
+			validators: []validator.Int32{
+				testvalidator.ErrorInt32("Error 1", "error 1 detail"),
+				testvalidator.ErrorInt32("Error 2", "error 2 detail"),
+			},
+			expected: function.NewArgumentFuncError(0, "Error 1: error 1 detail"),
+		},
+		"error-before-unsupported": {
+			validators: []validator.Int32{
+				testvalidator.ErrorInt32("Error 1", "error 1 detail"),
+				testvalidator.WarningInt32("Warning 1", "warning 1 detail"),
+			},
+			expected: function.NewArgumentFuncError(0, "Error 1: error 1 detail"),
+		},
+		"unsupported": {
+			validators: []validator.Int32{
+				testvalidator.WarningInt32("Warning 1", "warning 1 detail"),
+				testvalidator.ErrorInt32("Error 1", "error 1 detail"),
+			},
+			expected: validatorfuncerr.InvalidValidatorUsageFuncError(
+				0,
+				"Sequence",
+				"the validator at index 0 does not implement function.Int32ParameterValidator",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := function.Int32ParameterValidatorRequest{
+				ArgumentPosition: 0,
+			}
+			resp := &function.Int32ParameterValidatorResponse{}
+
+			int32validator.Sequence(testCase.validators...).ValidateParameterInt32(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Error); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSequenceValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := int32validator.Sequence(
+		testvalidator.WarningInt32("Warning 1", "warning 1 detail"),
+		testvalidator.ErrorInt32("Error 1", "error 1 detail"),
+	)
+
+	expected := "Value must satisfy each of the validations in order: always returns a warning diagnostic then always returns an error diagnostic"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
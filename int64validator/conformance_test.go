@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Int64{
+		"AtLeast":            int64validator.AtLeast(1),
+		"AtMost":             int64validator.AtMost(1),
+		"Between":            int64validator.Between(-1, 1),
+		"OneOf":              int64validator.OneOf(1, 2),
+		"NoneOf":             int64validator.NoneOf(1, 2),
+		"All":                int64validator.All(int64validator.AtLeast(1), int64validator.AtMost(2)),
+		"Any":                int64validator.Any(int64validator.AtLeast(2), int64validator.AtMost(-2)),
+		"AnyWithAllWarnings": int64validator.AnyWithAllWarnings(int64validator.AtLeast(2), int64validator.AtMost(-2)),
+		"AsWarning":          int64validator.AsWarning(int64validator.AtLeast(1)),
+		"AnyBestMatch":       int64validator.AnyBestMatch(int64validator.AtLeast(2), int64validator.AtMost(-2)),
+		"Sequence":           int64validator.Sequence(int64validator.AtLeast(1)),
+		"Sensitive":          int64validator.Sensitive(int64validator.AtLeast(1)),
+		"WithMessage":        int64validator.WithMessage(int64validator.AtLeast(1), "Summary", "{{.Detail}}"),
+		"DeprecatedValues":   int64validator.DeprecatedValues(map[int64]string{1: "use 2 instead"}),
+		"OneOfFunc":          int64validator.OneOfFunc(func(context.Context) ([]int64, error) { return []int64{1, 2}, nil }, int64validator.OneOfFuncOptions{}),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: int64validator.All(int64validator.AtLeast(1), int64validator.AtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: int64validator.Any(int64validator.AtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: int64validator.AnyBestMatch(int64validator.AtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: int64validator.AnyWithAllWarnings(int64validator.AtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: int64validator.AsWarning(int64validator.AtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"Sensitive": {
+			validator: int64validator.Sensitive(int64validator.AtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Min:  new(big.Float).SetInt64(1),
+			},
+		},
+		"Sequence": {
+			validator: int64validator.Sequence(int64validator.AtLeast(1), int64validator.AtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: int64validator.WithMessage(int64validator.AtLeast(1), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Min:  new(big.Float).SetInt64(1),
+			},
+		},
+		"AtMost": {
+			validator: int64validator.AtMost(2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Max:  new(big.Float).SetInt64(2),
+			},
+		},
+		"Between": {
+			validator: int64validator.Between(1, 2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRange,
+				Min:  new(big.Float).SetInt64(1),
+				Max:  new(big.Float).SetInt64(2),
+			},
+		},
+		"OneOf": {
+			validator: int64validator.OneOf(1, 2),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindOneOf,
+				Values: validatorspec.Values([]types.Int64{types.Int64Value(1), types.Int64Value(2)}),
+			},
+		},
+		"NoneOf": {
+			validator: int64validator.NoneOf(1, 2),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindNoneOf,
+				Values: validatorspec.Values([]types.Int64{types.Int64Value(1), types.Int64Value(2)}),
+			},
+		},
+		"DeprecatedValues": {
+			validator: int64validator.DeprecatedValues(map[int64]string{2: "use 3", 1: "use 3"}),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindDeprecatedValues,
+				Values: validatorspec.Values([]types.Int64{types.Int64Value(1), types.Int64Value(2)}),
+			},
+		},
+		"AtLeastSumOf": {
+			validator: int64validator.AtLeastSumOf(path.MatchRoot("a"), path.MatchRoot("b")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindAtLeastSumOf,
+				Paths: path.Expressions{path.MatchRoot("a"), path.MatchRoot("b")},
+			},
+		},
+		"AtMostSumOf": {
+			validator: int64validator.AtMostSumOf(path.MatchRoot("a")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindAtMostSumOf,
+				Paths: path.Expressions{path.MatchRoot("a")},
+			},
+		},
+		"EqualToSumOf": {
+			validator: int64validator.EqualToSumOf(path.MatchRoot("a")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindEqualToSumOf,
+				Paths: path.Expressions{path.MatchRoot("a")},
+			},
+		},
+		"EqualToProductOf": {
+			validator: int64validator.EqualToProductOf(path.MatchRoot("a")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindEqualToProductOf,
+				Paths: path.Expressions{path.MatchRoot("a")},
+			},
+		},
+		"OneOfFunc": {
+			validator: int64validator.OneOfFunc(func(context.Context) ([]int64, error) { return nil, nil }, int64validator.OneOfFuncOptions{}),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindOneOfFunc,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
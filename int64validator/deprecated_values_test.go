@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+)
+
+func TestDeprecatedValuesValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		in        types.Int64
+		validator validator.Int64
+		expected  diag.Diagnostics
+	}
+
+	values := map[int64]string{
+		1: "use 2 instead",
+	}
+
+	testCases := map[string]testCase{
+		"not-deprecated": {
+			in:        types.Int64Value(2),
+			validator: int64validator.DeprecatedValues(values),
+		},
+		"deprecated": {
+			in:        types.Int64Value(1),
+			validator: int64validator.DeprecatedValues(values),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Attribute Value",
+					`Attribute test value 1 is deprecated: use 2 instead`,
+				),
+			},
+		},
+		"skip-validation-on-null": {
+			in:        types.Int64Null(),
+			validator: int64validator.DeprecatedValues(values),
+		},
+		"skip-validation-on-unknown": {
+			in:        types.Int64Unknown(),
+			validator: int64validator.DeprecatedValues(values),
+		},
+		"until-date-not-passed": {
+			in: types.Int64Value(1),
+			validator: int64validator.DeprecatedValuesUntil(values, int64validator.DeprecationDeadline{
+				Date: time.Date(2999, 1, 1, 0, 0, 0, 0, time.UTC),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Attribute Value",
+					`Attribute test value 1 is deprecated: use 2 instead`,
+				),
+			},
+		},
+		"until-date-passed": {
+			in: types.Int64Value(1),
+			validator: int64validator.DeprecatedValuesUntil(values, int64validator.DeprecationDeadline{
+				Date: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Unsupported Attribute Value",
+					`Attribute test value 1 is no longer supported: use 2 instead`,
+				),
+			},
+		},
+		"until-version-not-passed": {
+			in: types.Int64Value(1),
+			validator: int64validator.DeprecatedValuesUntil(values, int64validator.DeprecationDeadline{
+				Version:         "6.0.0",
+				ProviderVersion: "5.4.0",
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Attribute Value",
+					`Attribute test value 1 is deprecated: use 2 instead`,
+				),
+			},
+		},
+		"until-version-passed": {
+			in: types.Int64Value(1),
+			validator: int64validator.DeprecatedValuesUntil(values, int64validator.DeprecationDeadline{
+				Version:         "6.0.0",
+				ProviderVersion: "6.0.0",
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Unsupported Attribute Value",
+					`Attribute test value 1 is no longer supported: use 2 instead`,
+				),
+			},
+		},
+		"until-not-deprecated": {
+			in: types.Int64Value(2),
+			validator: int64validator.DeprecatedValuesUntil(values, int64validator.DeprecationDeadline{
+				Date: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			}),
+		},
+		"until-invalid-deadline": {
+			in:        types.Int64Value(2),
+			validator: int64validator.DeprecatedValuesUntil(values, int64validator.DeprecationDeadline{}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Validator Usage",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						`An invalid usage of the "DeprecatedValuesUntil" validator was found: deadline requires at least one of Date or Version`,
+				),
+			},
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: test.in,
+			}
+			res := validator.Int64Response{}
+			test.validator.ValidateInt64(context.TODO(), req, &res)
+
+			if diff := cmp.Diff(res.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeprecatedValuesValidator_Description(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator validator.Int64
+		expected  string
+	}
+
+	values := map[int64]string{
+		1: "use 2 instead",
+		3: "use 2 instead",
+	}
+
+	testCases := map[string]testCase{
+		"deprecated-values": {
+			validator: int64validator.DeprecatedValues(values),
+			expected:  `value should not be one of the deprecated values: [1 3]`,
+		},
+		"deprecated-values-until": {
+			validator: int64validator.DeprecatedValuesUntil(values, int64validator.DeprecationDeadline{
+				Date:    time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+				Version: "6.0.0",
+			}),
+			expected: `value should not be one of the deprecated values: [1 3], which are unsupported from 2027-01-01 or provider version 6.0.0`,
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.validator.MarkdownDescription(context.Background())
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleDeprecatedValuesUntil() {
+	// Typically set at build time by the provider
+	providerVersion := "5.4.0"
+
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					// Warn when the deprecated value 1 is configured, then
+					// return an error from provider version 6.0.0
+					int64validator.DeprecatedValuesUntil(
+						map[int64]string{
+							1: "use 2 instead",
+						},
+						int64validator.DeprecationDeadline{
+							Version:         "6.0.0",
+							ProviderVersion: providerVersion,
+						},
+					),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+)
+
+func FuzzAtLeast(f *testing.F) {
+	validatortest.FuzzInt64(f, int64validator.AtLeast(10))
+}
+
+func FuzzAtMost(f *testing.F) {
+	validatortest.FuzzInt64(f, int64validator.AtMost(10))
+}
+
+func FuzzBetween(f *testing.F) {
+	validatortest.FuzzInt64(f, int64validator.Between(-10, 10))
+}
+
+func FuzzOneOf(f *testing.F) {
+	validatortest.FuzzInt64(f, int64validator.OneOf(1, 2, 3))
+}
+
+func FuzzNoneOf(f *testing.F) {
+	validatortest.FuzzInt64(f, int64validator.NoneOf(1, 2, 3))
+}
+
+func FuzzOneOfFunc(f *testing.F) {
+	validatortest.FuzzInt64(f, int64validator.OneOfFunc(func(context.Context) ([]int64, error) { return []int64{1, 2, 3}, nil }, int64validator.OneOfFuncOptions{}))
+}
+
+func FuzzDeprecatedValues(f *testing.F) {
+	validatortest.FuzzInt64(f, int64validator.DeprecatedValues(map[int64]string{1: "use 2 instead"}))
+}
+
+func FuzzAny(f *testing.F) {
+	validatortest.FuzzInt64(f, int64validator.Any(int64validator.AtMost(-10), int64validator.AtLeast(10)))
+}
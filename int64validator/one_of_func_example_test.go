@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator_test
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleOneOfFunc() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					// Validate int value must be one of the values loaded
+					// during validation, loading them at most once
+					int64validator.OneOfFunc(
+						func(_ context.Context) ([]int64, error) {
+							return []int64{1, 2, 3}, nil
+						},
+						int64validator.OneOfFuncOptions{
+							Memoize: true,
+						},
+					),
+				},
+			},
+		},
+	}
+}
+
+func ExampleOneOfFunc_function() {
+	_ = function.Definition{
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name: "example_param",
+				Validators: []function.Int64ParameterValidator{
+					// Validate int value must be one of the values loaded
+					// during validation, loading them at most once
+					int64validator.OneOfFunc(
+						func(_ context.Context) ([]int64, error) {
+							return []int64{1, 2, 3}, nil
+						},
+						int64validator.OneOfFuncOptions{
+							Memoize: true,
+						},
+					),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+)
+
+func TestOneOfFuncValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		in          types.Int64
+		source      func(context.Context) ([]int64, error)
+		expectError bool
+	}
+
+	values := func(_ context.Context) ([]int64, error) {
+		return []int64{1, 2, 3}, nil
+	}
+
+	testCases := map[string]testCase{
+		"simple-match": {
+			in:     types.Int64Value(2),
+			source: values,
+		},
+		"simple-mismatch": {
+			in:          types.Int64Value(4),
+			source:      values,
+			expectError: true,
+		},
+		"source-error": {
+			in: types.Int64Value(2),
+			source: func(_ context.Context) ([]int64, error) {
+				return nil, errors.New("catalogue unavailable")
+			},
+			expectError: true,
+		},
+		"nil-source": {
+			in:          types.Int64Value(2),
+			expectError: true,
+		},
+		"skip-validation-on-null": {
+			in:     types.Int64Null(),
+			source: values,
+		},
+		"skip-validation-on-unknown": {
+			in:     types.Int64Unknown(),
+			source: values,
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(fmt.Sprintf("ValidateInt64 - %s", name), func(t *testing.T) {
+			t.Parallel()
+			req := validator.Int64Request{
+				ConfigValue: test.in,
+			}
+			res := validator.Int64Response{}
+			int64validator.OneOfFunc(test.source, int64validator.OneOfFuncOptions{}).ValidateInt64(context.TODO(), req, &res)
+
+			if !res.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if res.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", res.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterInt64 - %s", name), func(t *testing.T) {
+			t.Parallel()
+			req := function.Int64ParameterValidatorRequest{
+				Value: test.in,
+			}
+			res := function.Int64ParameterValidatorResponse{}
+			int64validator.OneOfFunc(test.source, int64validator.OneOfFuncOptions{}).ValidateParameterInt64(context.TODO(), req, &res)
+
+			if res.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if res.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", res.Error)
+			}
+		})
+	}
+}
+
+func TestOneOfFuncValidator_Memoize(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		options       int64validator.OneOfFuncOptions
+		expectedCalls int
+	}
+
+	testCases := map[string]testCase{
+		"disabled": {
+			options:       int64validator.OneOfFuncOptions{},
+			expectedCalls: 3,
+		},
+		"enabled": {
+			options: int64validator.OneOfFuncOptions{
+				Memoize: true,
+			},
+			expectedCalls: 1,
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			v := int64validator.OneOfFunc(func(_ context.Context) ([]int64, error) {
+				calls++
+
+				return []int64{1, 2, 3}, nil
+			}, test.options)
+
+			for range 3 {
+				req := validator.Int64Request{
+					ConfigValue: types.Int64Value(2),
+				}
+				res := validator.Int64Response{}
+				v.ValidateInt64(context.TODO(), req, &res)
+
+				if res.Diagnostics.HasError() {
+					t.Fatalf("got unexpected error: %s", res.Diagnostics)
+				}
+			}
+
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d source calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestOneOfFuncValidator_SourceError(t *testing.T) {
+	t.Parallel()
+
+	req := validator.Int64Request{
+		Path:        path.Root("test"),
+		ConfigValue: types.Int64Value(2),
+	}
+	res := validator.Int64Response{}
+	int64validator.OneOfFunc(func(_ context.Context) ([]int64, error) {
+		return nil, errors.New("catalogue unavailable")
+	}, int64validator.OneOfFuncOptions{}).ValidateInt64(context.TODO(), req, &res)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Unable to Determine Allowed Values",
+			"When validating attribute test, the allowed values could not be determined. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				`The value source of the "OneOfFunc" validator returned an error: catalogue unavailable`,
+		),
+	}
+
+	if diff := cmp.Diff(res.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestOneOfFuncValidator_Description(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	v := int64validator.OneOfFunc(func(_ context.Context) ([]int64, error) {
+		calls++
+
+		return nil, errors.New("catalogue unavailable")
+	}, int64validator.OneOfFuncOptions{})
+
+	expected := "value must be one of the allowed values"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected description difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(expected, v.MarkdownDescription(context.Background())); diff != "" {
+		t.Errorf("unexpected markdown description difference: %s", diff)
+	}
+
+	if calls != 0 {
+		t.Errorf("expected no source calls, got %d", calls)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -17,23 +16,35 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ datasource.ConfigValidator = &AtLeastOneOfValidator{}
 var _ provider.ConfigValidator = &AtLeastOneOfValidator{}
 var _ resource.ConfigValidator = &AtLeastOneOfValidator{}
+var _ validatorspec.ValidatorWithConstraint = &AtLeastOneOfValidator{}
 
 // AtLeastOneOfValidator is the underlying struct implementing AtLeastOneOf.
 type AtLeastOneOfValidator struct {
 	PathExpressions path.Expressions
 }
 
-func (v AtLeastOneOfValidator) Description(ctx context.Context) string {
-	return v.MarkdownDescription(ctx)
+func (v AtLeastOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("At least one of these attributes must be configured: %s", v.PathExpressions)
 }
 
-func (v AtLeastOneOfValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("At least one of these attributes must be configured: %s", v.PathExpressions)
+func (v AtLeastOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v AtLeastOneOfValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindAtLeastOneOf,
+		Paths: v.PathExpressions,
+	}
 }
 
 func (v AtLeastOneOfValidator) ValidateAction(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
@@ -65,7 +76,7 @@ func (v AtLeastOneOfValidator) Validate(ctx context.Context, config tfsdk.Config
 	var diags diag.Diagnostics
 
 	for _, expression := range v.PathExpressions {
-		matchedPaths, matchedPathsDiags := config.PathMatches(ctx, expression)
+		matchedPaths, matchedPathsDiags := validatorcache.PathMatches(ctx, config, expression)
 
 		diags.Append(matchedPathsDiags...)
 
@@ -75,8 +86,7 @@ func (v AtLeastOneOfValidator) Validate(ctx context.Context, config tfsdk.Config
 		}
 
 		for _, matchedPath := range matchedPaths {
-			var value attr.Value
-			getAttributeDiags := config.GetAttribute(ctx, matchedPath, &value)
+			value, getAttributeDiags := validatorcache.GetAttribute(ctx, config, matchedPath)
 
 			diags.Append(getAttributeDiags...)
 
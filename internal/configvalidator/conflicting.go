@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 	"github.com/hashicorp/terraform-plugin-framework/action"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -23,18 +25,26 @@ import (
 var _ datasource.ConfigValidator = &ConflictingValidator{}
 var _ provider.ConfigValidator = &ConflictingValidator{}
 var _ resource.ConfigValidator = &ConflictingValidator{}
+var _ validatorspec.ValidatorWithConstraint = &ConflictingValidator{}
 
 // ConflictingValidator is the underlying struct implementing ConflictsWith.
 type ConflictingValidator struct {
 	PathExpressions path.Expressions
 }
 
-func (v ConflictingValidator) Description(ctx context.Context) string {
-	return v.MarkdownDescription(ctx)
+func (v ConflictingValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("These attributes cannot be configured together: %s", v.PathExpressions)
 }
 
-func (v ConflictingValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("These attributes cannot be configured together: %s", v.PathExpressions)
+func (v ConflictingValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v ConflictingValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindConflicting,
+		Paths: v.PathExpressions,
+	}
 }
 
 func (v ConflictingValidator) ValidateAction(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
@@ -66,7 +76,7 @@ func (v ConflictingValidator) Validate(ctx context.Context, config tfsdk.Config)
 	var diags diag.Diagnostics
 
 	for _, expression := range v.PathExpressions {
-		matchedPaths, matchedPathsDiags := config.PathMatches(ctx, expression)
+		matchedPaths, matchedPathsDiags := validatorcache.PathMatches(ctx, config, expression)
 
 		diags.Append(matchedPathsDiags...)
 
@@ -76,8 +86,7 @@ func (v ConflictingValidator) Validate(ctx context.Context, config tfsdk.Config)
 		}
 
 		for _, matchedPath := range matchedPaths {
-			var value attr.Value
-			getAttributeDiags := config.GetAttribute(ctx, matchedPath, &value)
+			value, getAttributeDiags := validatorcache.GetAttribute(ctx, config, matchedPath)
 
 			diags.Append(getAttributeDiags...)
 
@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 	"github.com/hashicorp/terraform-plugin-framework/action"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -23,18 +25,26 @@ import (
 var _ datasource.ConfigValidator = &ExactlyOneOfValidator{}
 var _ provider.ConfigValidator = &ExactlyOneOfValidator{}
 var _ resource.ConfigValidator = &ExactlyOneOfValidator{}
+var _ validatorspec.ValidatorWithConstraint = &ExactlyOneOfValidator{}
 
 // ExactlyOneOfValidator is the underlying struct implementing ExactlyOneOf.
 type ExactlyOneOfValidator struct {
 	PathExpressions path.Expressions
 }
 
-func (v ExactlyOneOfValidator) Description(ctx context.Context) string {
-	return v.MarkdownDescription(ctx)
+func (v ExactlyOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Exactly one of these attributes must be configured: %s", v.PathExpressions)
 }
 
-func (v ExactlyOneOfValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("Exactly one of these attributes must be configured: %s", v.PathExpressions)
+func (v ExactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v ExactlyOneOfValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindExactlyOneOf,
+		Paths: v.PathExpressions,
+	}
 }
 
 func (v ExactlyOneOfValidator) ValidateAction(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
@@ -66,7 +76,7 @@ func (v ExactlyOneOfValidator) Validate(ctx context.Context, config tfsdk.Config
 	var diags diag.Diagnostics
 
 	for _, expression := range v.PathExpressions {
-		matchedPaths, matchedPathsDiags := config.PathMatches(ctx, expression)
+		matchedPaths, matchedPathsDiags := validatorcache.PathMatches(ctx, config, expression)
 
 		diags.Append(matchedPathsDiags...)
 
@@ -76,8 +86,7 @@ func (v ExactlyOneOfValidator) Validate(ctx context.Context, config tfsdk.Config
 		}
 
 		for _, matchedPath := range matchedPaths {
-			var value attr.Value
-			getAttributeDiags := config.GetAttribute(ctx, matchedPath, &value)
+			value, getAttributeDiags := validatorcache.GetAttribute(ctx, config, matchedPath)
 
 			diags.Append(getAttributeDiags...)
 
@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 	"github.com/hashicorp/terraform-plugin-framework/action"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -23,18 +25,26 @@ import (
 var _ datasource.ConfigValidator = &RequiredTogetherValidator{}
 var _ provider.ConfigValidator = &RequiredTogetherValidator{}
 var _ resource.ConfigValidator = &RequiredTogetherValidator{}
+var _ validatorspec.ValidatorWithConstraint = &RequiredTogetherValidator{}
 
 // RequiredTogetherValidator is the underlying struct implementing RequiredTogether.
 type RequiredTogetherValidator struct {
 	PathExpressions path.Expressions
 }
 
-func (v RequiredTogetherValidator) Description(ctx context.Context) string {
-	return v.MarkdownDescription(ctx)
+func (v RequiredTogetherValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("These attributes must be configured together: %s", v.PathExpressions)
 }
 
-func (v RequiredTogetherValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("These attributes must be configured together: %s", v.PathExpressions)
+func (v RequiredTogetherValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v RequiredTogetherValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindRequiredTogether,
+		Paths: v.PathExpressions,
+	}
 }
 
 func (v RequiredTogetherValidator) ValidateAction(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
@@ -66,7 +76,7 @@ func (v RequiredTogetherValidator) Validate(ctx context.Context, config tfsdk.Co
 	var diags diag.Diagnostics
 
 	for _, expression := range v.PathExpressions {
-		matchedPaths, matchedPathsDiags := config.PathMatches(ctx, expression)
+		matchedPaths, matchedPathsDiags := validatorcache.PathMatches(ctx, config, expression)
 
 		diags.Append(matchedPathsDiags...)
 
@@ -80,8 +90,7 @@ func (v RequiredTogetherValidator) Validate(ctx context.Context, config tfsdk.Co
 		foundPaths.Append(matchedPaths...)
 
 		for _, matchedPath := range matchedPaths {
-			var value attr.Value
-			getAttributeDiags := config.GetAttribute(ctx, matchedPath, &value)
+			value, getAttributeDiags := validatorcache.GetAttribute(ctx, config, matchedPath)
 
 			diags.Append(getAttributeDiags...)
 
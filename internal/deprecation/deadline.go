@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deprecation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Deadline is the point after which deprecated values are no longer
+// supported. A zero Date or empty Version disables that condition.
+type Deadline struct {
+	Date            time.Time
+	Version         string
+	ProviderVersion string
+}
+
+// Validate returns an error if the deadline is not usable.
+func (d Deadline) Validate() error {
+	if d.Date.IsZero() && d.Version == "" {
+		return errors.New("deadline requires at least one of Date or Version")
+	}
+
+	if d.Version != "" {
+		if _, ok := parseVersion(d.Version); !ok {
+			return fmt.Errorf("deadline Version must be a semantic version, such as \"6.0.0\", got: %q", d.Version)
+		}
+	}
+
+	return nil
+}
+
+// Passed returns true if the date has been reached or the provider version
+// is greater than or equal to the version. A provider version which is not a
+// semantic version, such as "dev", never reaches the version.
+func (d Deadline) Passed(now time.Time) bool {
+	if !d.Date.IsZero() && !now.Before(d.Date) {
+		return true
+	}
+
+	if d.Version == "" {
+		return false
+	}
+
+	version, ok := parseVersion(d.Version)
+
+	if !ok {
+		return false
+	}
+
+	providerVersion, ok := parseVersion(d.ProviderVersion)
+
+	if !ok {
+		return false
+	}
+
+	return compareVersions(providerVersion, version) >= 0
+}
+
+// String describes the deadline for use in descriptions.
+func (d Deadline) String() string {
+	var conditions []string
+
+	if !d.Date.IsZero() {
+		conditions = append(conditions, d.Date.Format(time.DateOnly))
+	}
+
+	if d.Version != "" {
+		conditions = append(conditions, "provider version "+d.Version)
+	}
+
+	return strings.Join(conditions, " or ")
+}
+
+// version is a parsed semantic version.
+type version struct {
+	core       [3]uint64
+	prerelease []string
+}
+
+// parseVersion parses a semantic version, with an optional "v" prefix.
+// Build metadata is ignored.
+func parseVersion(s string) (version, bool) {
+	var v version
+
+	s = strings.TrimPrefix(s, "v")
+	s, _, _ = strings.Cut(s, "+")
+	s, prerelease, hasPrerelease := strings.Cut(s, "-")
+
+	parts := strings.Split(s, ".")
+
+	if len(parts) != 3 {
+		return v, false
+	}
+
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+
+		if err != nil {
+			return v, false
+		}
+
+		v.core[i] = n
+	}
+
+	if hasPrerelease {
+		v.prerelease = strings.Split(prerelease, ".")
+
+		for _, identifier := range v.prerelease {
+			if identifier == "" {
+				return v, false
+			}
+		}
+	}
+
+	return v, true
+}
+
+// compareVersions returns -1, 0, or 1 if a is less than, equal to, or greater
+// than b by semantic version precedence.
+func compareVersions(a, b version) int {
+	for i := range a.core {
+		if a.core[i] != b.core[i] {
+			if a.core[i] < b.core[i] {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	// A version without a prerelease has higher precedence.
+	switch {
+	case len(a.prerelease) == 0 && len(b.prerelease) == 0:
+		return 0
+	case len(a.prerelease) == 0:
+		return 1
+	case len(b.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(a.prerelease) && i < len(b.prerelease); i++ {
+		if c := comparePrereleaseIdentifiers(a.prerelease[i], b.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(a.prerelease) < len(b.prerelease):
+		return -1
+	case len(a.prerelease) > len(b.prerelease):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparePrereleaseIdentifiers compares numeric identifiers numerically and
+// other identifiers lexically, with numeric identifiers having lower
+// precedence.
+func comparePrereleaseIdentifiers(a, b string) int {
+	numberA, errA := strconv.ParseUint(a, 10, 64)
+	numberB, errB := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case errA == nil && errB == nil:
+		switch {
+		case numberA < numberB:
+			return -1
+		case numberA > numberB:
+			return 1
+		default:
+			return 0
+		}
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deprecation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeadlinePassed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		deadline Deadline
+		expected bool
+	}
+
+	tests := map[string]testCase{
+		"date-before": {
+			deadline: Deadline{Date: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)},
+			expected: false,
+		},
+		"date-equal": {
+			deadline: Deadline{Date: now},
+			expected: true,
+		},
+		"date-after": {
+			deadline: Deadline{Date: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)},
+			expected: true,
+		},
+		"version-older": {
+			deadline: Deadline{Version: "6.0.0", ProviderVersion: "5.99.1"},
+			expected: false,
+		},
+		"version-equal": {
+			deadline: Deadline{Version: "6.0.0", ProviderVersion: "v6.0.0"},
+			expected: true,
+		},
+		"version-newer": {
+			deadline: Deadline{Version: "6.0.0", ProviderVersion: "6.10.0+build.1"},
+			expected: true,
+		},
+		"version-prerelease-older": {
+			deadline: Deadline{Version: "6.0.0", ProviderVersion: "6.0.0-beta.1"},
+			expected: false,
+		},
+		"version-prerelease-numeric": {
+			deadline: Deadline{Version: "6.0.0-beta.2", ProviderVersion: "6.0.0-beta.10"},
+			expected: true,
+		},
+		"version-prerelease-shorter": {
+			deadline: Deadline{Version: "6.0.0-beta.1", ProviderVersion: "6.0.0-beta"},
+			expected: false,
+		},
+		"version-provider-dev": {
+			deadline: Deadline{Version: "6.0.0", ProviderVersion: "dev"},
+			expected: false,
+		},
+		"version-provider-empty": {
+			deadline: Deadline{Version: "6.0.0"},
+			expected: false,
+		},
+		"date-or-version": {
+			deadline: Deadline{
+				Date:            time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+				Version:         "6.0.0",
+				ProviderVersion: "6.0.0",
+			},
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.deadline.Passed(now); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDeadlineValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		deadline    Deadline
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"date": {
+			deadline: Deadline{Date: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		"version": {
+			deadline: Deadline{Version: "v6.0.0-rc.1"},
+		},
+		"empty": {
+			expectError: true,
+		},
+		"invalid-version": {
+			deadline:    Deadline{Version: "6.0"},
+			expectError: true,
+		},
+		"invalid-prerelease": {
+			deadline:    Deadline{Version: "6.0.0-beta..1"},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.deadline.Validate()
+
+			if err == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package deprecation provides the deadline handling of the
+// DeprecatedValuesUntil validators of the exported packages:
+//   - int32validator
+//   - int64validator
+//   - stringvalidator
+package deprecation
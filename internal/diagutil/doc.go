@@ -0,0 +1,6 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package diagutil provides diagnostic transformations shared by the
+// validator wrappers of the exported packages.
+package diagutil
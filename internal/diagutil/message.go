@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagutil
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// MessageData is the data available to a Message detail template.
+type MessageData struct {
+	// Path is the path of the diagnostic, which is empty for function
+	// parameters and diagnostics without a path.
+	Path string
+
+	// Value is the string representation of the validated value, which is
+	// empty for configuration validators.
+	Value string
+
+	// Summary is the original summary, which is empty for function
+	// parameters.
+	Summary string
+
+	// Detail is the original detail, or the original error text for
+	// function parameters.
+	Detail string
+}
+
+// Message replaces the summary and detail of error diagnostics.
+type Message struct {
+	summary string
+	detail  *template.Template
+	err     error
+}
+
+// NewMessage returns a Message with the given summary and detail template.
+// An empty summary or detail template keeps the original summary or detail.
+func NewMessage(summary string, detailTemplate string) Message {
+	m := Message{
+		summary: summary,
+	}
+
+	if detailTemplate == "" {
+		return m
+	}
+
+	detail, err := template.New("detail").Parse(detailTemplate)
+
+	if err != nil {
+		m.err = fmt.Errorf("detailTemplate must be a valid text/template: %w", err)
+
+		return m
+	}
+
+	// Execute the template once so that references to unknown fields are
+	// reported before validation.
+	if err := detail.Execute(io.Discard, MessageData{}); err != nil {
+		m.err = fmt.Errorf("detailTemplate must only reference .Path, .Value, .Summary, and .Detail: %w", err)
+
+		return m
+	}
+
+	m.detail = detail
+
+	return m
+}
+
+// Err returns an error if the detail template is invalid.
+func (m Message) Err() error {
+	return m.err
+}
+
+// Diagnostics returns the diagnostics with the summary and detail of each
+// error diagnostic replaced. Other diagnostics are returned unchanged.
+func (m Message) Diagnostics(diags diag.Diagnostics, value string) diag.Diagnostics {
+	if diags == nil {
+		return nil
+	}
+
+	result := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			result = append(result, d)
+
+			continue
+		}
+
+		data := MessageData{
+			Value:   value,
+			Summary: d.Summary(),
+			Detail:  d.Detail(),
+		}
+
+		withPath, ok := d.(diag.DiagnosticWithPath)
+
+		if ok {
+			data.Path = withPath.Path().String()
+		}
+
+		summary := m.summary
+
+		if summary == "" {
+			summary = d.Summary()
+		}
+
+		detail := m.render(data)
+
+		if ok {
+			result = append(result, diag.NewAttributeErrorDiagnostic(withPath.Path(), summary, detail))
+
+			continue
+		}
+
+		result = append(result, diag.NewErrorDiagnostic(summary, detail))
+	}
+
+	return result
+}
+
+// FuncError returns the function error with its text replaced by the
+// summary, if any, followed by the rendered detail.
+func (m Message) FuncError(funcErr *function.FuncError, value string) *function.FuncError {
+	if funcErr == nil {
+		return nil
+	}
+
+	text := m.render(MessageData{
+		Value:  value,
+		Detail: funcErr.Text,
+	})
+
+	if m.summary != "" {
+		text = m.summary + ": " + text
+	}
+
+	if funcErr.FunctionArgument != nil {
+		return function.NewArgumentFuncError(*funcErr.FunctionArgument, text)
+	}
+
+	return function.NewFuncError(text)
+}
+
+// render returns the rendered detail template, or the original detail if
+// there is no template or it cannot be rendered.
+func (m Message) render(data MessageData) string {
+	if m.detail == nil {
+		return data.Detail
+	}
+
+	var b strings.Builder
+
+	if err := m.detail.Execute(&b, data); err != nil {
+		return data.Detail
+	}
+
+	return b.String()
+}
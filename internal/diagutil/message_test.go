@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagutil
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestMessageDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		summary        string
+		detailTemplate string
+		diags          diag.Diagnostics
+		expected       diag.Diagnostics
+	}
+
+	tests := map[string]testCase{
+		"nil": {
+			summary:  "Custom Summary",
+			diags:    nil,
+			expected: nil,
+		},
+		"summary-and-detail": {
+			summary:        "Invalid Bucket Name",
+			detailTemplate: "Bucket names at {{.Path}} must be 3-63 characters, got: {{.Value}}",
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Original Summary", "original detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Invalid Bucket Name", `Bucket names at test must be 3-63 characters, got: "ab"`),
+			},
+		},
+		"original-detail": {
+			detailTemplate: "{{.Summary}}: {{.Detail}}. See the documentation.",
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Original Summary", "original detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Original Summary", "Original Summary: original detail. See the documentation."),
+			},
+		},
+		"summary-only": {
+			summary: "Custom Summary",
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Original Summary", "original detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Custom Summary", "original detail"),
+			},
+		},
+		"warnings-unchanged": {
+			summary:        "Custom Summary",
+			detailTemplate: "custom detail",
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Original Summary", "original detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Original Summary", "original detail"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := NewMessage(test.summary, test.detailTemplate)
+
+			if err := m.Err(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := m.Diagnostics(test.diags, `"ab"`)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMessageFuncError(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		summary        string
+		detailTemplate string
+		funcErr        *function.FuncError
+		expected       *function.FuncError
+	}
+
+	tests := map[string]testCase{
+		"nil": {
+			summary:  "Custom Summary",
+			funcErr:  nil,
+			expected: nil,
+		},
+		"summary-and-detail": {
+			summary:        "Invalid Bucket Name",
+			detailTemplate: "must be 3-63 characters, got: {{.Value}}",
+			funcErr:        function.NewArgumentFuncError(1, "original text"),
+			expected:       function.NewArgumentFuncError(1, `Invalid Bucket Name: must be 3-63 characters, got: "ab"`),
+		},
+		"detail-only": {
+			detailTemplate: "{{.Detail}} (see the documentation)",
+			funcErr:        function.NewFuncError("original text"),
+			expected:       function.NewFuncError("original text (see the documentation)"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewMessage(test.summary, test.detailTemplate).FuncError(test.funcErr, `"ab"`)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewMessageErr(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		detailTemplate string
+		expectError    bool
+	}
+
+	tests := map[string]testCase{
+		"empty": {},
+		"valid": {
+			detailTemplate: "{{.Path}} {{.Value}} {{.Summary}} {{.Detail}}",
+		},
+		"invalid-syntax": {
+			detailTemplate: "{{.Path",
+			expectError:    true,
+		},
+		"unknown-field": {
+			detailTemplate: "{{.Attribute}}",
+			expectError:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewMessage("", test.detailTemplate).Err()
+
+			if err == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagutil
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+)
+
+// gotSeparator precedes the value in validatordiag and validatorfuncerr
+// messages, such as "Attribute x value must be ..., got: <value>".
+const gotSeparator = ", got: "
+
+// minRedactedSecretLength is the minimum length of a secret which is replaced
+// wherever it appears within messages. Shorter secrets are only replaced where
+// they are not part of a longer word or number, as they would otherwise
+// replace unrelated text, such as single letters within words.
+const minRedactedSecretLength = 4
+
+// RedactDiagnostics returns the diagnostics with sensitive values replaced by
+// validatordiag.RedactedValue, preserving the severity and path. Any text
+// following ", got: " is replaced, as are any occurrences of the given
+// secrets.
+func RedactDiagnostics(diags diag.Diagnostics, secrets ...string) diag.Diagnostics {
+	if diags == nil {
+		return nil
+	}
+
+	result := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		summary := Redact(d.Summary(), secrets...)
+		detail := Redact(d.Detail(), secrets...)
+
+		withPath, hasPath := d.(diag.DiagnosticWithPath)
+
+		switch {
+		case d.Severity() == diag.SeverityError && hasPath:
+			result = append(result, diag.NewAttributeErrorDiagnostic(withPath.Path(), summary, detail))
+		case d.Severity() == diag.SeverityError:
+			result = append(result, diag.NewErrorDiagnostic(summary, detail))
+		case hasPath:
+			result = append(result, diag.NewAttributeWarningDiagnostic(withPath.Path(), summary, detail))
+		default:
+			result = append(result, diag.NewWarningDiagnostic(summary, detail))
+		}
+	}
+
+	return result
+}
+
+// RedactFuncError returns the function error with sensitive values replaced
+// in the same manner as RedactDiagnostics.
+func RedactFuncError(funcErr *function.FuncError, secrets ...string) *function.FuncError {
+	if funcErr == nil {
+		return nil
+	}
+
+	text := Redact(funcErr.Text, secrets...)
+
+	if funcErr.FunctionArgument != nil {
+		return function.NewArgumentFuncError(*funcErr.FunctionArgument, text)
+	}
+
+	return function.NewFuncError(text)
+}
+
+// Redact returns the text with sensitive values replaced.
+func Redact(text string, secrets ...string) string {
+	// Replace longer secrets first, so a quoted secret is replaced as a
+	// whole rather than leaving its quotes.
+	secrets = slices.SortedFunc(slices.Values(secrets), func(a, b string) int {
+		return cmp.Compare(len(b), len(a))
+	})
+
+	// Replace secrets in a single pass, so replacements are not matched by
+	// shorter secrets.
+	var b strings.Builder
+
+	for i := 0; i < len(text); {
+		if secret, ok := matchSecret(text, i, secrets); ok {
+			b.WriteString(validatordiag.RedactedValue)
+			i += len(secret)
+
+			continue
+		}
+
+		b.WriteByte(text[i])
+		i++
+	}
+
+	// Each line of concatenated function errors may contain a value.
+	lines := strings.Split(b.String(), "\n")
+
+	for i, line := range lines {
+		if index := strings.LastIndex(line, gotSeparator); index >= 0 {
+			lines[i] = line[:index+len(gotSeparator)] + validatordiag.RedactedValue
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// matchSecret returns the first of the secrets found at the start index of
+// the text.
+func matchSecret(text string, start int, secrets []string) (string, bool) {
+	for _, secret := range secrets {
+		if secret == "" || !strings.HasPrefix(text[start:], secret) {
+			continue
+		}
+
+		if len(secret) >= minRedactedSecretLength || isWhole(text, start, start+len(secret)) {
+			return secret, true
+		}
+	}
+
+	return "", false
+}
+
+// isWhole returns true if the text between the start and end indexes does
+// not continue a word or number of the surrounding text.
+func isWhole(text string, start int, end int) bool {
+	first, _ := utf8.DecodeRuneInString(text[start:end])
+	last, _ := utf8.DecodeLastRuneInString(text[start:end])
+
+	if before, size := utf8.DecodeLastRuneInString(text[:start]); size > 0 && isWordRune(first) && isWordRune(before) {
+		return false
+	}
+
+	if after, size := utf8.DecodeRuneInString(text[end:]); size > 0 && isWordRune(last) && isWordRune(after) {
+		return false
+	}
+
+	return true
+}
+
+// isWordRune returns true if the rune is a letter or digit.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
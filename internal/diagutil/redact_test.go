@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagutil
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		text     string
+		secrets  []string
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"got": {
+			text:     `Attribute password value must be one of: ["a" "b"], got: "hunter2". Did you mean "hunter"?`,
+			expected: `Attribute password value must be one of: ["a" "b"], got: (sensitive value)`,
+		},
+		"secrets": {
+			text:     `value "hunter2" is not allowed, hunter2 must be longer`,
+			secrets:  []string{"hunter2", `"hunter2"`},
+			expected: `value (sensitive value) is not allowed, (sensitive value) must be longer`,
+		},
+		"short-secret": {
+			text:     "value must be at least 8 characters",
+			secrets:  []string{"a"},
+			expected: "value must be at least 8 characters",
+		},
+		"short-secret-whole": {
+			text:     `value a is not allowed, as "a" is reserved: a`,
+			secrets:  []string{"a", `"a"`},
+			expected: `value (sensitive value) is not allowed, as (sensitive value) is reserved: (sensitive value)`,
+		},
+		"short-secret-number": {
+			text:     "Attribute list[10] value 1 must be at least 12",
+			secrets:  []string{"1"},
+			expected: "Attribute list[10] value (sensitive value) must be at least 12",
+		},
+		"short-secret-symbols": {
+			text:     "value must not contain %%, got %% in it",
+			secrets:  []string{"%%"},
+			expected: "value must not contain (sensitive value), got (sensitive value) in it",
+		},
+		"secret-within-replacement": {
+			text:     `value "hunter2" and e`,
+			secrets:  []string{`"hunter2"`, "e"},
+			expected: `value (sensitive value) and (sensitive value)`,
+		},
+		"multiple-lines": {
+			text:     "Invalid Parameter Value: first, got: \"x1\"\nInvalid Parameter Value: second, got: \"x2\"",
+			expected: "Invalid Parameter Value: first, got: (sensitive value)\nInvalid Parameter Value: second, got: (sensitive value)",
+		},
+		"no-value": {
+			text:     "Attribute password string length must be at least 8, got: 5",
+			expected: "Attribute password string length must be at least 8, got: (sensitive value)",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(Redact(test.text, test.secrets...), test.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRedactDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	diags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(path.Root("test"), "Invalid Attribute Value", `Attribute test value must be lowercase, got: "SECRET"`),
+		diag.NewWarningDiagnostic("Deprecated Attribute Value", `Attribute test value "SECRET" is deprecated: use another`),
+	}
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(path.Root("test"), "Invalid Attribute Value", `Attribute test value must be lowercase, got: (sensitive value)`),
+		diag.NewWarningDiagnostic("Deprecated Attribute Value", `Attribute test value (sensitive value) is deprecated: use another`),
+	}
+
+	if diff := cmp.Diff(RedactDiagnostics(diags, `"SECRET"`, "SECRET"), expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestRedactFuncError(t *testing.T) {
+	t.Parallel()
+
+	got := RedactFuncError(function.NewArgumentFuncError(0, `Invalid Parameter Value: value must be lowercase, got: "SECRET"`))
+	expected := function.NewArgumentFuncError(0, `Invalid Parameter Value: value must be lowercase, got: (sensitive value)`)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+}
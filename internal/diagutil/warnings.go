@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagutil
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ErrorsToWarnings returns the diagnostics with each error diagnostic
+// converted into a warning diagnostic with the same path, summary, and
+// detail. Other diagnostics are returned unchanged.
+func ErrorsToWarnings(diags diag.Diagnostics) diag.Diagnostics {
+	if diags == nil {
+		return nil
+	}
+
+	result := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			result = append(result, d)
+
+			continue
+		}
+
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			result = append(result, diag.NewAttributeWarningDiagnostic(withPath.Path(), d.Summary(), d.Detail()))
+
+			continue
+		}
+
+		result = append(result, diag.NewWarningDiagnostic(d.Summary(), d.Detail()))
+	}
+
+	return result
+}
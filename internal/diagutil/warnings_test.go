@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagutil
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestErrorsToWarnings(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}
+
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			expected: nil,
+		},
+		"errors": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "error detail"),
+				diag.NewErrorDiagnostic("Error Summary", "error detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Error Summary", "error detail"),
+				diag.NewWarningDiagnostic("Error Summary", "error detail"),
+			},
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning Summary", "warning detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning Summary", "warning detail"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ErrorsToWarnings(test.diags)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
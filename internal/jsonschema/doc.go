@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package jsonschema provides a validator for a subset of JSON Schema draft
+// 2020-12, used by the stringvalidator.JSONMatchesSchema validator.
+//
+// The supported keywords are:
+//   - $ref, limited to JSON Pointers within the same document, such as
+//     "#/$defs/name"
+//   - additionalProperties
+//   - enum and const
+//   - exclusiveMaximum, exclusiveMinimum, maximum, and minimum
+//   - items
+//   - maxItems and minItems
+//   - maxLength and minLength
+//   - pattern, using RE2 rather than ECMA-262 regular expression syntax
+//   - properties and required
+//   - type
+//
+// Annotation keywords, such as title, description, $schema, $id, $defs, and
+// format, are ignored. Any other keyword is rejected when the schema is
+// compiled, so unsupported constraints are never silently skipped.
+package jsonschema
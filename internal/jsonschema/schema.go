@@ -0,0 +1,486 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// annotationKeywords are accepted but have no effect on validation.
+var annotationKeywords = map[string]bool{
+	"$anchor":     true,
+	"$comment":    true,
+	"$defs":       true,
+	"$id":         true,
+	"$schema":     true,
+	"$vocabulary": true,
+	"default":     true,
+	"deprecated":  true,
+	"description": true,
+	"examples":    true,
+	"format":      true,
+	"readOnly":    true,
+	"title":       true,
+	"writeOnly":   true,
+}
+
+// validTypes are the values allowed by the type keyword.
+var validTypes = map[string]bool{
+	"array":   true,
+	"boolean": true,
+	"integer": true,
+	"null":    true,
+	"number":  true,
+	"object":  true,
+	"string":  true,
+}
+
+// Schema is a compiled JSON Schema.
+type Schema struct {
+	// never is true for the false boolean schema, which no value satisfies.
+	never bool
+
+	types                []string
+	properties           map[string]*Schema
+	required             []string
+	additionalProperties *Schema
+	items                *Schema
+
+	enum     []any
+	hasConst bool
+	constant any
+
+	pattern *regexp.Regexp
+
+	minimum, maximum                   *number
+	exclusiveMinimum, exclusiveMaximum *number
+
+	minLength, maxLength *int
+	minItems, maxItems   *int
+
+	ref *Schema
+}
+
+// number is a numeric keyword value.
+type number struct {
+	value *big.Rat
+
+	// text is the original representation, used in violations.
+	text string
+}
+
+// Violation is a single reason a value does not satisfy a schema.
+type Violation struct {
+	// Pointer is the RFC 6901 JSON Pointer to the failing value, which is
+	// the empty string for the root value.
+	Pointer string
+
+	// Description describes the constraint which was not satisfied.
+	Description string
+
+	// Value describes the failing value.
+	Value string
+}
+
+// compiler holds the state of a single Compile call.
+type compiler struct {
+	root any
+
+	// refs are the compiled $ref targets by pointer. Entries are created
+	// before the target is compiled to support recursive schemas.
+	refs map[string]*Schema
+
+	// refKeywords are the schemas containing a $ref keyword, in compilation
+	// order, and the pointer of the keyword.
+	refKeywords []refKeyword
+}
+
+// refKeyword is a schema containing a $ref keyword.
+type refKeyword struct {
+	schema  *Schema
+	pointer string
+}
+
+// Compile parses and compiles a JSON Schema document.
+func Compile(document []byte) (*Schema, error) {
+	root, err := Decode(document)
+
+	if err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %w", err)
+	}
+
+	c := &compiler{
+		root: root,
+		refs: make(map[string]*Schema),
+	}
+
+	schema := &Schema{}
+
+	if err := c.compile(schema, root, ""); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkRefCycles(); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// Decode parses a JSON document, preserving numbers as json.Number values.
+func Decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+
+	return value, nil
+}
+
+// compile populates schema from the raw schema value found at the given
+// pointer within the document.
+func (c *compiler) compile(schema *Schema, raw any, pointer string) error {
+	switch raw := raw.(type) {
+	case bool:
+		schema.never = !raw
+
+		return nil
+	case map[string]any:
+		return c.compileObject(schema, raw, pointer)
+	default:
+		return fmt.Errorf("schema at %q must be an object or boolean", pointer)
+	}
+}
+
+func (c *compiler) compileObject(schema *Schema, raw map[string]any, pointer string) error {
+	// Sort keywords so the first error is deterministic.
+	keywords := make([]string, 0, len(raw))
+
+	for keyword := range raw {
+		keywords = append(keywords, keyword)
+	}
+
+	sort.Strings(keywords)
+
+	for _, keyword := range keywords {
+		value := raw[keyword]
+		keywordPointer := pointer + "/" + escapePointerToken(keyword)
+
+		var err error
+
+		switch keyword {
+		case "$ref":
+			err = c.compileRef(schema, value, keywordPointer)
+		case "additionalProperties":
+			schema.additionalProperties = &Schema{}
+			err = c.compile(schema.additionalProperties, value, keywordPointer)
+		case "const":
+			schema.hasConst = true
+			schema.constant = value
+		case "enum":
+			values, ok := value.([]any)
+
+			if !ok {
+				return fmt.Errorf("schema keyword at %q must be an array", keywordPointer)
+			}
+
+			schema.enum = values
+		case "exclusiveMaximum":
+			schema.exclusiveMaximum, err = compileNumber(value, keywordPointer)
+		case "exclusiveMinimum":
+			schema.exclusiveMinimum, err = compileNumber(value, keywordPointer)
+		case "items":
+			schema.items = &Schema{}
+			err = c.compile(schema.items, value, keywordPointer)
+		case "maximum":
+			schema.maximum, err = compileNumber(value, keywordPointer)
+		case "maxItems":
+			schema.maxItems, err = compileCount(value, keywordPointer)
+		case "maxLength":
+			schema.maxLength, err = compileCount(value, keywordPointer)
+		case "minimum":
+			schema.minimum, err = compileNumber(value, keywordPointer)
+		case "minItems":
+			schema.minItems, err = compileCount(value, keywordPointer)
+		case "minLength":
+			schema.minLength, err = compileCount(value, keywordPointer)
+		case "pattern":
+			err = compilePattern(schema, value, keywordPointer)
+		case "properties":
+			err = c.compileProperties(schema, value, keywordPointer)
+		case "required":
+			schema.required, err = compileStrings(value, keywordPointer)
+		case "type":
+			err = compileType(schema, value, keywordPointer)
+		default:
+			if !annotationKeywords[keyword] {
+				return fmt.Errorf("schema keyword at %q is not supported", keywordPointer)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *compiler) compileRef(schema *Schema, value any, pointer string) error {
+	ref, ok := value.(string)
+
+	if !ok {
+		return fmt.Errorf("schema keyword at %q must be a string", pointer)
+	}
+
+	if ref != "#" && !strings.HasPrefix(ref, "#/") {
+		return fmt.Errorf("schema keyword at %q must be a JSON Pointer within the document, such as \"#/$defs/name\", got: %q", pointer, ref)
+	}
+
+	c.refKeywords = append(c.refKeywords, refKeyword{schema: schema, pointer: pointer})
+
+	if target, ok := c.refs[ref]; ok {
+		schema.ref = target
+
+		return nil
+	}
+
+	raw, err := resolvePointer(c.root, strings.TrimPrefix(ref, "#"))
+
+	if err != nil {
+		return fmt.Errorf("schema keyword at %q cannot be resolved: %w", pointer, err)
+	}
+
+	target := &Schema{}
+	c.refs[ref] = target
+	schema.ref = target
+
+	return c.compile(target, raw, strings.TrimPrefix(ref, "#"))
+}
+
+// checkRefCycles returns an error if following $ref keywords from a schema
+// leads back to a schema already followed. Such references apply to the same
+// value without ever reaching a nested value, so validation would never end.
+// References through keywords such as items or properties are allowed, as
+// they validate nested values.
+func (c *compiler) checkRefCycles() error {
+	for _, keyword := range c.refKeywords {
+		visited := map[*Schema]bool{keyword.schema: true}
+
+		for current := keyword.schema.ref; current != nil; current = current.ref {
+			if visited[current] {
+				return fmt.Errorf("schema keyword at %q references itself without validating a nested value", keyword.pointer)
+			}
+
+			visited[current] = true
+		}
+	}
+
+	return nil
+}
+
+func (c *compiler) compileProperties(schema *Schema, value any, pointer string) error {
+	properties, ok := value.(map[string]any)
+
+	if !ok {
+		return fmt.Errorf("schema keyword at %q must be an object", pointer)
+	}
+
+	schema.properties = make(map[string]*Schema, len(properties))
+
+	for name, raw := range properties {
+		property := &Schema{}
+
+		if err := c.compile(property, raw, pointer+"/"+escapePointerToken(name)); err != nil {
+			return err
+		}
+
+		schema.properties[name] = property
+	}
+
+	return nil
+}
+
+func compileType(schema *Schema, value any, pointer string) error {
+	var types []string
+
+	switch value := value.(type) {
+	case string:
+		types = []string{value}
+	case []any:
+		var err error
+
+		types, err = compileStrings(value, pointer)
+
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("schema keyword at %q must be a string or array of strings", pointer)
+	}
+
+	for _, t := range types {
+		if !validTypes[t] {
+			return fmt.Errorf("schema keyword at %q contains unknown type %q", pointer, t)
+		}
+	}
+
+	schema.types = types
+
+	return nil
+}
+
+func compilePattern(schema *Schema, value any, pointer string) error {
+	pattern, ok := value.(string)
+
+	if !ok {
+		return fmt.Errorf("schema keyword at %q must be a string", pointer)
+	}
+
+	re, err := regexp.Compile(pattern)
+
+	if err != nil {
+		return fmt.Errorf("schema keyword at %q must be a valid regular expression: %w", pointer, err)
+	}
+
+	schema.pattern = re
+
+	return nil
+}
+
+func compileNumber(value any, pointer string) (*number, error) {
+	n, ok := value.(json.Number)
+
+	if !ok {
+		return nil, fmt.Errorf("schema keyword at %q must be a number", pointer)
+	}
+
+	r, err := parseNumber(n)
+
+	if err != nil {
+		return nil, fmt.Errorf("schema keyword at %q must be a number", pointer)
+	}
+
+	return &number{value: r, text: n.String()}, nil
+}
+
+func compileCount(value any, pointer string) (*int, error) {
+	n, ok := value.(json.Number)
+
+	if !ok {
+		return nil, fmt.Errorf("schema keyword at %q must be a non-negative integer", pointer)
+	}
+
+	count, err := strconv.Atoi(n.String())
+
+	if err != nil || count < 0 {
+		return nil, fmt.Errorf("schema keyword at %q must be a non-negative integer", pointer)
+	}
+
+	return &count, nil
+}
+
+func compileStrings(value any, pointer string) ([]string, error) {
+	values, ok := value.([]any)
+
+	if !ok {
+		return nil, fmt.Errorf("schema keyword at %q must be an array of strings", pointer)
+	}
+
+	result := make([]string, 0, len(values))
+
+	for _, v := range values {
+		s, ok := v.(string)
+
+		if !ok {
+			return nil, fmt.Errorf("schema keyword at %q must be an array of strings", pointer)
+		}
+
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// maxNumberExponent is the largest supported absolute decimal exponent of a
+// number, which prevents excessive memory usage by exact comparisons.
+const maxNumberExponent = 1000
+
+// parseNumber converts a JSON number into an exact rational value.
+func parseNumber(n json.Number) (*big.Rat, error) {
+	if i := strings.IndexAny(n.String(), "eE"); i >= 0 {
+		exponent, err := strconv.Atoi(n.String()[i+1:])
+
+		if err != nil || exponent > maxNumberExponent || exponent < -maxNumberExponent {
+			return nil, fmt.Errorf("number %q exponent out of range", n)
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(n.String())
+
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", n)
+	}
+
+	return r, nil
+}
+
+// resolvePointer returns the value at the RFC 6901 JSON Pointer within the
+// document.
+func resolvePointer(document any, pointer string) (any, error) {
+	if pointer == "" {
+		return document, nil
+	}
+
+	current := document
+
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch value := current.(type) {
+		case map[string]any:
+			next, ok := value[token]
+
+			if !ok {
+				return nil, fmt.Errorf("no value at %q", pointer)
+			}
+
+			current = next
+		case []any:
+			index, err := strconv.Atoi(token)
+
+			if err != nil || index < 0 || index >= len(value) {
+				return nil, fmt.Errorf("no value at %q", pointer)
+			}
+
+			current = value[index]
+		default:
+			return nil, fmt.Errorf("no value at %q", pointer)
+		}
+	}
+
+	return current, nil
+}
+
+// escapePointerToken escapes a single RFC 6901 JSON Pointer reference token.
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
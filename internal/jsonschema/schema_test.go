@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsonschema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/jsonschema"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema      string
+		expectError bool
+	}{
+		"empty": {
+			schema: `{}`,
+		},
+		"boolean": {
+			schema: `true`,
+		},
+		"annotations": {
+			schema: `{"$schema": "https://json-schema.org/draft/2020-12/schema", "title": "t", "description": "d", "format": "uri"}`,
+		},
+		"recursive ref": {
+			schema: `{"$defs": {"node": {"type": "object", "properties": {"children": {"type": "array", "items": {"$ref": "#/$defs/node"}}}}}, "$ref": "#/$defs/node"}`,
+		},
+		"root ref": {
+			schema: `{"type": "object", "additionalProperties": {"$ref": "#"}}`,
+		},
+		"invalid JSON": {
+			schema:      `{`,
+			expectError: true,
+		},
+		"invalid schema type": {
+			schema:      `"string"`,
+			expectError: true,
+		},
+		"unsupported keyword": {
+			schema:      `{"oneOf": [{"type": "string"}]}`,
+			expectError: true,
+		},
+		"unknown type": {
+			schema:      `{"type": "text"}`,
+			expectError: true,
+		},
+		"external ref": {
+			schema:      `{"$ref": "https://example.com/schema.json"}`,
+			expectError: true,
+		},
+		"self ref": {
+			schema:      `{"$ref": "#"}`,
+			expectError: true,
+		},
+		"cyclic ref": {
+			schema:      `{"$defs": {"a": {"$ref": "#/$defs/a"}}, "$ref": "#/$defs/a"}`,
+			expectError: true,
+		},
+		"indirect cyclic ref": {
+			schema:      `{"$defs": {"a": {"$ref": "#/$defs/b"}, "b": {"type": "string", "$ref": "#/$defs/a"}}, "$ref": "#/$defs/a"}`,
+			expectError: true,
+		},
+		"unresolvable ref": {
+			schema:      `{"$ref": "#/$defs/missing"}`,
+			expectError: true,
+		},
+		"invalid pattern": {
+			schema:      `{"pattern": "(?=a)"}`,
+			expectError: true,
+		},
+		"invalid count": {
+			schema:      `{"minLength": -1}`,
+			expectError: true,
+		},
+		"invalid number": {
+			schema:      `{"minimum": "1"}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.Compile([]byte(testCase.schema))
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	t.Parallel()
+
+	const schema = `{
+		"type": "object",
+		"required": ["name", "widgets"],
+		"additionalProperties": false,
+		"properties": {
+			"name": {"type": "string", "minLength": 1, "maxLength": 8, "pattern": "^[a-z]+$"},
+			"version": {"const": 1},
+			"refresh": {"type": "integer", "minimum": 5, "exclusiveMaximum": 3600},
+			"ratio": {"type": "number", "exclusiveMinimum": 0, "maximum": 1},
+			"widgets": {"type": "array", "minItems": 1, "maxItems": 2, "items": {"$ref": "#/$defs/widget"}}
+		},
+		"$defs": {
+			"widget": {
+				"type": "object",
+				"required": ["kind"],
+				"properties": {
+					"kind": {"enum": ["graph", "table"]},
+					"a/b": {"type": "boolean"}
+				},
+				"additionalProperties": {"type": "string"}
+			}
+		}
+	}`
+
+	testCases := map[string]struct {
+		value    string
+		expected []jsonschema.Violation
+	}{
+		"valid": {
+			value: `{"name": "dash", "version": 1.0, "refresh": 60, "ratio": 0.5, "widgets": [{"kind": "graph", "a/b": true, "title": "t"}]}`,
+		},
+		"root type": {
+			value: `[]`,
+			expected: []jsonschema.Violation{
+				{Pointer: "", Description: "must be of type object", Value: "array"},
+			},
+		},
+		"every violation": {
+			value: `{"name": "Dashboard!", "version": 2, "refresh": 3600, "ratio": 0, "extra": null, "widgets": [{"kind": "pie", "a/b": "yes", "title": 1}, {}, {"kind": "table"}]}`,
+			expected: []jsonschema.Violation{
+				{Pointer: "", Description: `must not contain additional property "extra"`, Value: "additional property"},
+				{Pointer: "/name", Description: "string length must be at most 8", Value: "10"},
+				{Pointer: "/name", Description: `must match pattern "^[a-z]+$"`, Value: `"Dashboard!"`},
+				{Pointer: "/ratio", Description: "must be greater than 0", Value: "0"},
+				{Pointer: "/refresh", Description: "must be less than 3600", Value: "3600"},
+				{Pointer: "/version", Description: "must be equal to 1", Value: "2"},
+				{Pointer: "/widgets", Description: "must contain at most 2 items", Value: "3"},
+				{Pointer: "/widgets/0/a~1b", Description: "must be of type boolean", Value: "string"},
+				{Pointer: "/widgets/0/kind", Description: `must be one of: ["graph", "table"]`, Value: `"pie"`},
+				{Pointer: "/widgets/0/title", Description: "must be of type string", Value: "number"},
+				{Pointer: "/widgets/1", Description: `must contain property "kind"`, Value: "object without property"},
+			},
+		},
+		"missing required": {
+			value: `{"name": "a"}`,
+			expected: []jsonschema.Violation{
+				{Pointer: "", Description: `must contain property "widgets"`, Value: "object without property"},
+			},
+		},
+		"integer type": {
+			value: `{"name": "a", "refresh": 10.5, "widgets": [{"kind": "graph"}]}`,
+			expected: []jsonschema.Violation{
+				{Pointer: "/refresh", Description: "must be of type integer", Value: "number"},
+			},
+		},
+		"number out of range": {
+			value: `{"name": "a", "ratio": 1e99999, "widgets": [{"kind": "graph"}]}`,
+			expected: []jsonschema.Violation{
+				{Pointer: "/ratio", Description: "must be a number within the supported range", Value: "1e99999"},
+			},
+		},
+	}
+
+	compiled, err := jsonschema.Compile([]byte(schema))
+
+	if err != nil {
+		t.Fatalf("unexpected error compiling schema: %s", err)
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := jsonschema.Decode([]byte(testCase.value))
+
+			if err != nil {
+				t.Fatalf("unexpected error decoding value: %s", err)
+			}
+
+			got := compiled.Validate(value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"object": {
+			value: `{"a": 1}`,
+		},
+		"scalar": {
+			value: `"a"`,
+		},
+		"invalid": {
+			value:       `{"a": }`,
+			expectError: true,
+		},
+		"trailing whitespace": {
+			value: "{\"a\": 1} \n",
+		},
+		"trailing data": {
+			value:       `{"a": 1} {"b": 2}`,
+			expectError: true,
+		},
+		"trailing garbage": {
+			value:       `{"a": 1} xyz`,
+			expectError: true,
+		},
+		"trailing closing brace": {
+			value:       `{"a": 1} }`,
+			expectError: true,
+		},
+		"trailing closing bracket": {
+			value:       `{"a": 1}]`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonschema.Decode([]byte(testCase.value))
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxDescribedValueLength is the maximum length of a string value included in
+// a Violation before it is truncated.
+const maxDescribedValueLength = 64
+
+// Validate returns every violation of the schema by the given value, which
+// must have been decoded with Decode.
+func (s *Schema) Validate(value any) []Violation {
+	var violations []Violation
+
+	s.validate(value, "", &violations)
+
+	return violations
+}
+
+func (s *Schema) validate(value any, pointer string, violations *[]Violation) {
+	add := func(description string, got string) {
+		*violations = append(*violations, Violation{
+			Pointer:     pointer,
+			Description: description,
+			Value:       got,
+		})
+	}
+
+	if s.never {
+		add("must not be present", describeValue(value))
+
+		return
+	}
+
+	if s.ref != nil {
+		s.ref.validate(value, pointer, violations)
+	}
+
+	if len(s.types) > 0 && !slices.ContainsFunc(s.types, func(t string) bool { return hasType(value, t) }) {
+		add(fmt.Sprintf("must be of type %s", strings.Join(s.types, " or ")), typeOf(value))
+
+		// Further keywords would only produce confusing violations.
+		return
+	}
+
+	if s.hasConst && !equal(value, s.constant) {
+		add(fmt.Sprintf("must be equal to %s", encode(s.constant)), describeValue(value))
+	}
+
+	if len(s.enum) > 0 && !slices.ContainsFunc(s.enum, func(e any) bool { return equal(value, e) }) {
+		allowed := make([]string, 0, len(s.enum))
+
+		for _, e := range s.enum {
+			allowed = append(allowed, encode(e))
+		}
+
+		add(fmt.Sprintf("must be one of: [%s]", strings.Join(allowed, ", ")), describeValue(value))
+	}
+
+	switch value := value.(type) {
+	case string:
+		s.validateString(value, add)
+	case json.Number:
+		s.validateNumber(value, add)
+	case []any:
+		s.validateArray(value, pointer, add, violations)
+	case map[string]any:
+		s.validateObject(value, pointer, add, violations)
+	}
+}
+
+func (s *Schema) validateString(value string, add func(string, string)) {
+	length := utf8.RuneCountInString(value)
+
+	if s.minLength != nil && length < *s.minLength {
+		add(fmt.Sprintf("string length must be at least %d", *s.minLength), strconv.Itoa(length))
+	}
+
+	if s.maxLength != nil && length > *s.maxLength {
+		add(fmt.Sprintf("string length must be at most %d", *s.maxLength), strconv.Itoa(length))
+	}
+
+	if s.pattern != nil && !s.pattern.MatchString(value) {
+		add(fmt.Sprintf("must match pattern %q", s.pattern), describeValue(value))
+	}
+}
+
+func (s *Schema) validateNumber(value json.Number, add func(string, string)) {
+	n, err := parseNumber(value)
+
+	if err != nil {
+		add("must be a number within the supported range", value.String())
+
+		return
+	}
+
+	if s.minimum != nil && n.Cmp(s.minimum.value) < 0 {
+		add(fmt.Sprintf("must be greater than or equal to %s", s.minimum.text), value.String())
+	}
+
+	if s.maximum != nil && n.Cmp(s.maximum.value) > 0 {
+		add(fmt.Sprintf("must be less than or equal to %s", s.maximum.text), value.String())
+	}
+
+	if s.exclusiveMinimum != nil && n.Cmp(s.exclusiveMinimum.value) <= 0 {
+		add(fmt.Sprintf("must be greater than %s", s.exclusiveMinimum.text), value.String())
+	}
+
+	if s.exclusiveMaximum != nil && n.Cmp(s.exclusiveMaximum.value) >= 0 {
+		add(fmt.Sprintf("must be less than %s", s.exclusiveMaximum.text), value.String())
+	}
+}
+
+func (s *Schema) validateArray(value []any, pointer string, add func(string, string), violations *[]Violation) {
+	if s.minItems != nil && len(value) < *s.minItems {
+		add(fmt.Sprintf("must contain at least %d items", *s.minItems), strconv.Itoa(len(value)))
+	}
+
+	if s.maxItems != nil && len(value) > *s.maxItems {
+		add(fmt.Sprintf("must contain at most %d items", *s.maxItems), strconv.Itoa(len(value)))
+	}
+
+	if s.items != nil {
+		for index, item := range value {
+			s.items.validate(item, pointer+"/"+strconv.Itoa(index), violations)
+		}
+	}
+}
+
+func (s *Schema) validateObject(value map[string]any, pointer string, add func(string, string), violations *[]Violation) {
+	for _, name := range s.required {
+		if _, ok := value[name]; !ok {
+			add(fmt.Sprintf("must contain property %q", name), "object without property")
+		}
+	}
+
+	// Sort property names so violations are deterministic.
+	names := make([]string, 0, len(value))
+
+	for name := range value {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertyPointer := pointer + "/" + escapePointerToken(name)
+
+		if property, ok := s.properties[name]; ok {
+			property.validate(value[name], propertyPointer, violations)
+
+			continue
+		}
+
+		if s.additionalProperties == nil {
+			continue
+		}
+
+		if s.additionalProperties.never {
+			add(fmt.Sprintf("must not contain additional property %q", name), "additional property")
+
+			continue
+		}
+
+		s.additionalProperties.validate(value[name], propertyPointer, violations)
+	}
+}
+
+// hasType returns true if the value is of the given JSON Schema type.
+func hasType(value any, t string) bool {
+	switch t {
+	case "integer":
+		n, ok := value.(json.Number)
+
+		if !ok {
+			return false
+		}
+
+		r, err := parseNumber(n)
+
+		return err == nil && r.IsInt()
+	default:
+		return typeOf(value) == t
+	}
+}
+
+// typeOf returns the JSON Schema type name of the value.
+func typeOf(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// describeValue returns a short representation of the value for use in
+// violations. Arrays and objects are summarized rather than encoded.
+func describeValue(value any) string {
+	switch value := value.(type) {
+	case []any:
+		return fmt.Sprintf("array of %d items", len(value))
+	case map[string]any:
+		return fmt.Sprintf("object with %d properties", len(value))
+	case string:
+		if utf8.RuneCountInString(value) > maxDescribedValueLength {
+			value = string([]rune(value)[:maxDescribedValueLength]) + "..."
+		}
+
+		return strconv.Quote(value)
+	default:
+		return encode(value)
+	}
+}
+
+// encode returns the JSON encoding of the value.
+func encode(value any) string {
+	b, err := json.Marshal(value)
+
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(b)
+}
+
+// equal returns true if the values are equal according to JSON Schema,
+// where numbers are compared by their mathematical value.
+func equal(a, b any) bool {
+	switch a := a.(type) {
+	case json.Number:
+		b, ok := b.(json.Number)
+
+		if !ok {
+			return false
+		}
+
+		x, errA := parseNumber(a)
+		y, errB := parseNumber(b)
+
+		return errA == nil && errB == nil && x.Cmp(y) == 0
+	case []any:
+		b, ok := b.([]any)
+
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	case map[string]any:
+		b, ok := b.(map[string]any)
+
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for name, value := range a {
+			other, ok := b[name]
+
+			if !ok || !equal(value, other) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return a == b
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package memoize provides optional caching of function results for the
+// OneOfFunc validators of the exported packages:
+//   - int64validator
+//   - numbervalidator
+//   - stringvalidator
+package memoize
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package memoize
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Func calls a function which loads a value, optionally caching the first
+// successful result. Errors are never cached.
+type Func[T any] struct {
+	load    func(context.Context) (T, error)
+	enabled bool
+	ttl     time.Duration
+
+	// now returns the current time, which is replaceable in tests.
+	now func() time.Time
+
+	mu       sync.Mutex
+	loaded   bool
+	value    T
+	loadedAt time.Time
+}
+
+// New returns a Func for the given load function. If enabled is false, every
+// call to Get calls the load function. Otherwise, the result is cached for the
+// given time to live, where zero caches the result indefinitely.
+func New[T any](load func(context.Context) (T, error), enabled bool, ttl time.Duration) *Func[T] {
+	return &Func[T]{
+		load:    load,
+		enabled: enabled,
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached value, if any, or calls the load function.
+// Concurrent callers wait for a single load to complete.
+func (f *Func[T]) Get(ctx context.Context) (T, error) {
+	if !f.enabled {
+		return f.load(ctx)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.loaded && (f.ttl == 0 || f.now().Sub(f.loadedAt) < f.ttl) {
+		return f.value, nil
+	}
+
+	value, err := f.load(ctx)
+
+	if err != nil {
+		return value, err
+	}
+
+	f.loaded = true
+	f.value = value
+	f.loadedAt = f.now()
+
+	return value, nil
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package memoize
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFuncGet(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		enabled       bool
+		ttl           time.Duration
+		advance       time.Duration
+		errors        []error
+		expectedCalls int
+		expectError   []bool
+	}
+
+	errLoad := errors.New("load failed")
+
+	tests := map[string]testCase{
+		"disabled": {
+			errors:        []error{nil, nil, nil},
+			expectedCalls: 3,
+			expectError:   []bool{false, false, false},
+		},
+		"enabled": {
+			enabled:       true,
+			errors:        []error{nil, nil, nil},
+			expectedCalls: 1,
+			expectError:   []bool{false, false, false},
+		},
+		"enabled errors not cached": {
+			enabled:       true,
+			errors:        []error{errLoad, nil, nil},
+			expectedCalls: 2,
+			expectError:   []bool{true, false, false},
+		},
+		"enabled ttl not expired": {
+			enabled:       true,
+			ttl:           time.Minute,
+			advance:       time.Second,
+			errors:        []error{nil, nil, nil},
+			expectedCalls: 1,
+			expectError:   []bool{false, false, false},
+		},
+		"enabled ttl expired": {
+			enabled:       true,
+			ttl:           time.Minute,
+			advance:       time.Minute,
+			errors:        []error{nil, nil, nil},
+			expectedCalls: 3,
+			expectError:   []bool{false, false, false},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			load := func(_ context.Context) (int, error) {
+				err := test.errors[calls]
+				calls++
+
+				return calls, err
+			}
+
+			now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			f := New(load, test.enabled, test.ttl)
+			f.now = func() time.Time { return now }
+
+			for i, expectError := range test.expectError {
+				_, err := f.Get(context.Background())
+
+				if err == nil && expectError {
+					t.Fatalf("call %d: expected error, got no error", i)
+				}
+
+				if err != nil && !expectError {
+					t.Fatalf("call %d: got unexpected error: %s", i, err)
+				}
+
+				now = now.Add(test.advance)
+			}
+
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemareflect provides access to the attributes, blocks, nested
+// objects, and validators of framework schemas, whose underlying types are
+// internal to the framework, for the exported packages:
+//   - helpers/validatorjsonschema
+//   - helpers/validatorspec
+package schemareflect
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemareflect
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Attributes returns the attributes of a schema or nested object.
+func Attributes(value any) map[string]any {
+	return namedValues(value, "GetAttributes")
+}
+
+// Blocks returns the blocks of a schema or nested block object.
+func Blocks(value any) map[string]any {
+	return namedValues(value, "GetBlocks")
+}
+
+// NestedObject returns the nested object of a nested attribute or block, if
+// any.
+func NestedObject(value any) (any, bool) {
+	return callMethod(value, "GetNestedObject")
+}
+
+// Validators returns the validators of an attribute, block, or nested
+// object, in order.
+func Validators(value any) []any {
+	switch v := value.(type) {
+	case interface{ BoolValidators() []validator.Bool }:
+		return anys(v.BoolValidators())
+	case interface{ DynamicValidators() []validator.Dynamic }:
+		return anys(v.DynamicValidators())
+	case interface{ Float32Validators() []validator.Float32 }:
+		return anys(v.Float32Validators())
+	case interface{ Float64Validators() []validator.Float64 }:
+		return anys(v.Float64Validators())
+	case interface{ Int32Validators() []validator.Int32 }:
+		return anys(v.Int32Validators())
+	case interface{ Int64Validators() []validator.Int64 }:
+		return anys(v.Int64Validators())
+	case interface{ ListValidators() []validator.List }:
+		return anys(v.ListValidators())
+	case interface{ MapValidators() []validator.Map }:
+		return anys(v.MapValidators())
+	case interface{ NumberValidators() []validator.Number }:
+		return anys(v.NumberValidators())
+	case interface{ ObjectValidators() []validator.Object }:
+		return anys(v.ObjectValidators())
+	case interface{ SetValidators() []validator.Set }:
+		return anys(v.SetValidators())
+	case interface{ StringValidators() []validator.String }:
+		return anys(v.StringValidators())
+	default:
+		return nil
+	}
+}
+
+func anys[V any](values []V) []any {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]any, 0, len(values))
+
+	for _, value := range values {
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// callMethod calls the method of the value without arguments and returns
+// its single result, if the method exists.
+func callMethod(value any, name string) (any, bool) {
+	rv := reflect.ValueOf(value)
+
+	if !rv.IsValid() {
+		return nil, false
+	}
+
+	method := rv.MethodByName(name)
+
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return nil, false
+	}
+
+	result := method.Call(nil)[0]
+
+	if (result.Kind() == reflect.Interface || result.Kind() == reflect.Map) && result.IsNil() {
+		return nil, false
+	}
+
+	return result.Interface(), true
+}
+
+// namedValues returns the map with string keys returned by the method of
+// the value, such as GetAttributes.
+func namedValues(value any, name string) map[string]any {
+	result, ok := callMethod(value, name)
+
+	if !ok {
+		return nil
+	}
+
+	rv := reflect.ValueOf(result)
+
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil
+	}
+
+	values := make(map[string]any, rv.Len())
+
+	for iter := rv.MapRange(); iter.Next(); {
+		values[iter.Key().String()] = iter.Value().Interface()
+	}
+
+	return values
+}
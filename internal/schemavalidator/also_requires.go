@@ -13,7 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // This type of validator must satisfy all types.
@@ -32,6 +35,8 @@ var (
 	_ validator.Dynamic = AlsoRequiresValidator{}
 )
 
+var _ validatorspec.ValidatorWithConstraint = AlsoRequiresValidator{}
+
 // AlsoRequiresValidator is the underlying struct implementing AlsoRequires.
 type AlsoRequiresValidator struct {
 	PathExpressions path.Expressions
@@ -48,12 +53,19 @@ type AlsoRequiresValidatorResponse struct {
 	Diagnostics diag.Diagnostics
 }
 
-func (av AlsoRequiresValidator) Description(ctx context.Context) string {
-	return av.MarkdownDescription(ctx)
+func (av AlsoRequiresValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Ensure that if an attribute is set, also these are set: %q", av.PathExpressions)
 }
 
-func (av AlsoRequiresValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("Ensure that if an attribute is set, also these are set: %q", av.PathExpressions)
+func (av AlsoRequiresValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, av)
+}
+
+func (av AlsoRequiresValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindAlsoRequires,
+		Paths: av.PathExpressions,
+	}
 }
 
 func (av AlsoRequiresValidator) Validate(ctx context.Context, req AlsoRequiresValidatorRequest, res *AlsoRequiresValidatorResponse) {
@@ -66,7 +78,7 @@ func (av AlsoRequiresValidator) Validate(ctx context.Context, req AlsoRequiresVa
 	expressions := req.PathExpression.MergeExpressions(av.PathExpressions...)
 
 	for _, expression := range expressions {
-		matchedPaths, diags := req.Config.PathMatches(ctx, expression)
+		matchedPaths, diags := validatorcache.PathMatches(ctx, req.Config, expression)
 
 		res.Diagnostics.Append(diags...)
 
@@ -82,8 +94,7 @@ func (av AlsoRequiresValidator) Validate(ctx context.Context, req AlsoRequiresVa
 				continue
 			}
 
-			var mpVal attr.Value
-			diags := req.Config.GetAttribute(ctx, mp, &mpVal)
+			mpVal, diags := validatorcache.GetAttribute(ctx, req.Config, mp)
 			res.Diagnostics.Append(diags...)
 
 			// Collect all errors
@@ -13,7 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // This type of validator must satisfy all types.
@@ -32,6 +35,8 @@ var (
 	_ validator.Dynamic = AtLeastOneOfValidator{}
 )
 
+var _ validatorspec.ValidatorWithConstraint = AtLeastOneOfValidator{}
+
 // AtLeastOneOfValidator is the underlying struct implementing AtLeastOneOf.
 type AtLeastOneOfValidator struct {
 	PathExpressions path.Expressions
@@ -48,12 +53,19 @@ type AtLeastOneOfValidatorResponse struct {
 	Diagnostics diag.Diagnostics
 }
 
-func (av AtLeastOneOfValidator) Description(ctx context.Context) string {
-	return av.MarkdownDescription(ctx)
+func (av AtLeastOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Ensure that at least one attribute from this collection is set: %s", av.PathExpressions)
 }
 
-func (av AtLeastOneOfValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("Ensure that at least one attribute from this collection is set: %s", av.PathExpressions)
+func (av AtLeastOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, av)
+}
+
+func (av AtLeastOneOfValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindAtLeastOneOf,
+		Paths: av.PathExpressions,
+	}
 }
 
 func (av AtLeastOneOfValidator) Validate(ctx context.Context, req AtLeastOneOfValidatorRequest, res *AtLeastOneOfValidatorResponse) {
@@ -66,7 +78,7 @@ func (av AtLeastOneOfValidator) Validate(ctx context.Context, req AtLeastOneOfVa
 	expressions := req.PathExpression.MergeExpressions(av.PathExpressions...)
 
 	for _, expression := range expressions {
-		matchedPaths, diags := req.Config.PathMatches(ctx, expression)
+		matchedPaths, diags := validatorcache.PathMatches(ctx, req.Config, expression)
 
 		res.Diagnostics.Append(diags...)
 
@@ -76,8 +88,7 @@ func (av AtLeastOneOfValidator) Validate(ctx context.Context, req AtLeastOneOfVa
 		}
 
 		for _, mp := range matchedPaths {
-			var mpVal attr.Value
-			diags := req.Config.GetAttribute(ctx, mp, &mpVal)
+			mpVal, diags := validatorcache.GetAttribute(ctx, req.Config, mp)
 			res.Diagnostics.Append(diags...)
 
 			// Collect all errors
@@ -13,7 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // This type of validator must satisfy all types.
@@ -32,6 +35,8 @@ var (
 	_ validator.Dynamic = ConflictsWithValidator{}
 )
 
+var _ validatorspec.ValidatorWithConstraint = ConflictsWithValidator{}
+
 // ConflictsWithValidator is the underlying struct implementing ConflictsWith.
 type ConflictsWithValidator struct {
 	PathExpressions path.Expressions
@@ -48,12 +53,19 @@ type ConflictsWithValidatorResponse struct {
 	Diagnostics diag.Diagnostics
 }
 
-func (av ConflictsWithValidator) Description(ctx context.Context) string {
-	return av.MarkdownDescription(ctx)
+func (av ConflictsWithValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Ensure that if an attribute is set, these are not set: %q", av.PathExpressions)
 }
 
-func (av ConflictsWithValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("Ensure that if an attribute is set, these are not set: %q", av.PathExpressions)
+func (av ConflictsWithValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, av)
+}
+
+func (av ConflictsWithValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindConflictsWith,
+		Paths: av.PathExpressions,
+	}
 }
 
 func (av ConflictsWithValidator) Validate(ctx context.Context, req ConflictsWithValidatorRequest, res *ConflictsWithValidatorResponse) {
@@ -66,7 +78,7 @@ func (av ConflictsWithValidator) Validate(ctx context.Context, req ConflictsWith
 	expressions := req.PathExpression.MergeExpressions(av.PathExpressions...)
 
 	for _, expression := range expressions {
-		matchedPaths, diags := req.Config.PathMatches(ctx, expression)
+		matchedPaths, diags := validatorcache.PathMatches(ctx, req.Config, expression)
 
 		res.Diagnostics.Append(diags...)
 
@@ -82,8 +94,7 @@ func (av ConflictsWithValidator) Validate(ctx context.Context, req ConflictsWith
 				continue
 			}
 
-			var mpVal attr.Value
-			diags := req.Config.GetAttribute(ctx, mp, &mpVal)
+			mpVal, diags := validatorcache.GetAttribute(ctx, req.Config, mp)
 			res.Diagnostics.Append(diags...)
 
 			// Collect all errors
@@ -13,7 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // This type of validator must satisfy all types.
@@ -32,6 +35,8 @@ var (
 	_ validator.Dynamic = ExactlyOneOfValidator{}
 )
 
+var _ validatorspec.ValidatorWithConstraint = ExactlyOneOfValidator{}
+
 // ExactlyOneOfValidator is the underlying struct implementing ExactlyOneOf.
 type ExactlyOneOfValidator struct {
 	PathExpressions path.Expressions
@@ -48,12 +53,19 @@ type ExactlyOneOfValidatorResponse struct {
 	Diagnostics diag.Diagnostics
 }
 
-func (av ExactlyOneOfValidator) Description(ctx context.Context) string {
-	return av.MarkdownDescription(ctx)
+func (av ExactlyOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Ensure that one and only one attribute from this collection is set: %q", av.PathExpressions)
 }
 
-func (av ExactlyOneOfValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("Ensure that one and only one attribute from this collection is set: %q", av.PathExpressions)
+func (av ExactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, av)
+}
+
+func (av ExactlyOneOfValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindExactlyOneOf,
+		Paths: av.PathExpressions,
+	}
 }
 
 func (av ExactlyOneOfValidator) Validate(ctx context.Context, req ExactlyOneOfValidatorRequest, res *ExactlyOneOfValidatorResponse) {
@@ -74,7 +86,7 @@ func (av ExactlyOneOfValidator) Validate(ctx context.Context, req ExactlyOneOfVa
 	}
 
 	for _, expression := range expressions {
-		matchedPaths, diags := req.Config.PathMatches(ctx, expression)
+		matchedPaths, diags := validatorcache.PathMatches(ctx, req.Config, expression)
 
 		res.Diagnostics.Append(diags...)
 
@@ -90,8 +102,7 @@ func (av ExactlyOneOfValidator) Validate(ctx context.Context, req ExactlyOneOfVa
 				continue
 			}
 
-			var mpVal attr.Value
-			diags := req.Config.GetAttribute(ctx, mp, &mpVal)
+			mpVal, diags := validatorcache.GetAttribute(ctx, req.Config, mp)
 			res.Diagnostics.Append(diags...)
 
 			// Collect all errors
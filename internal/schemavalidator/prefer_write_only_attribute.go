@@ -12,6 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorcache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // This type of validator must satisfy all types.
@@ -28,6 +32,8 @@ var (
 	_ validator.String  = PreferWriteOnlyAttribute{}
 )
 
+var _ validatorspec.ValidatorWithConstraint = PreferWriteOnlyAttribute{}
+
 // PreferWriteOnlyAttribute is the underlying struct implementing ExactlyOneOf.
 type PreferWriteOnlyAttribute struct {
 	WriteOnlyAttribute path.Expression
@@ -45,12 +51,19 @@ type PreferWriteOnlyAttributeResponse struct {
 	Diagnostics diag.Diagnostics
 }
 
-func (av PreferWriteOnlyAttribute) Description(ctx context.Context) string {
-	return av.MarkdownDescription(ctx)
+func (av PreferWriteOnlyAttribute) Description(_ context.Context) string {
+	return fmt.Sprintf("The write-only attribute %s should be preferred over this attribute", av.WriteOnlyAttribute)
 }
 
-func (av PreferWriteOnlyAttribute) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("The write-only attribute %s should be preferred over this attribute", av.WriteOnlyAttribute)
+func (av PreferWriteOnlyAttribute) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, av)
+}
+
+func (av PreferWriteOnlyAttribute) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindPreferWriteOnlyAttribute,
+		Paths: path.Expressions{av.WriteOnlyAttribute},
+	}
 }
 
 func (av PreferWriteOnlyAttribute) Validate(ctx context.Context, req PreferWriteOnlyAttributeRequest, resp *PreferWriteOnlyAttributeResponse) {
@@ -58,13 +71,13 @@ func (av PreferWriteOnlyAttribute) Validate(ctx context.Context, req PreferWrite
 		return
 	}
 
-	oldAttributePaths, oldAttributeDiags := req.Config.PathMatches(ctx, req.PathExpression)
+	oldAttributePaths, oldAttributeDiags := validatorcache.PathMatches(ctx, req.Config, req.PathExpression)
 	if oldAttributeDiags.HasError() {
 		resp.Diagnostics.Append(oldAttributeDiags...)
 		return
 	}
 
-	_, writeOnlyAttributeDiags := req.Config.PathMatches(ctx, av.WriteOnlyAttribute)
+	_, writeOnlyAttributeDiags := validatorcache.PathMatches(ctx, req.Config, av.WriteOnlyAttribute)
 	if writeOnlyAttributeDiags.HasError() {
 		resp.Diagnostics.Append(writeOnlyAttributeDiags...)
 		return
@@ -72,8 +85,7 @@ func (av PreferWriteOnlyAttribute) Validate(ctx context.Context, req PreferWrite
 
 	for _, mp := range oldAttributePaths {
 		// Get the value
-		var matchedValue attr.Value
-		diags := req.Config.GetAttribute(ctx, mp, &matchedValue)
+		matchedValue, diags := validatorcache.GetAttribute(ctx, req.Config, mp)
 		resp.Diagnostics.Append(diags...)
 		if diags.HasError() {
 			continue
@@ -0,0 +1,8 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package secretscan provides the credential detection of the secret
+// validators of the exported packages:
+//   - mapvalidator
+//   - stringvalidator
+package secretscan
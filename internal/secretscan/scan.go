@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretscan
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+)
+
+// DefaultEntropyThreshold is the Shannon entropy, in bits per character,
+// from which tokens are considered random enough to be credentials.
+const DefaultEntropyThreshold = 4.5
+
+// scaledEntropyTokenLength is the number of characters below which the
+// entropy threshold is scaled down. The entropy of a token of n characters is
+// at most log2(n) bits per character, which is below the default threshold
+// for tokens of up to 22 characters, so the threshold is multiplied by
+// log2(n) / log2(scaledEntropyTokenLength) for shorter tokens.
+const scaledEntropyTokenLength = 32
+
+// minEntropyTokenLength is the minimum number of characters of tokens which
+// are checked for entropy. Shorter tokens cannot reliably be distinguished
+// from identifiers.
+const minEntropyTokenLength = 20
+
+// maskPrefixLength is the number of leading characters of a match which are
+// shown in diagnostics.
+const maskPrefixLength = 4
+
+// Description describes the validation of the secret validators.
+const Description = "value should not contain credentials, such as access keys, tokens, or private keys"
+
+// Finding is a part of a value which resembles a credential.
+type Finding struct {
+	// Kind describes the credential, such as "an AWS access key ID".
+	Kind string
+
+	// Fragment is the masked match, which is safe to include in
+	// diagnostics.
+	Fragment string
+}
+
+// pattern is a well-known credential shape.
+type pattern struct {
+	kind   string
+	regexp *regexp.Regexp
+}
+
+var patterns = []pattern{
+	{
+		kind:   "an AWS access key ID",
+		regexp: regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16}\b`),
+	},
+	{
+		kind:   "a Google API key",
+		regexp: regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`),
+	},
+	{
+		kind:   "a GitHub token",
+		regexp: regexp.MustCompile(`\b(?:gh[pousr]_[0-9A-Za-z]{36,}|github_pat_[0-9A-Za-z_]{22,})`),
+	},
+	{
+		kind:   "a Slack token",
+		regexp: regexp.MustCompile(`\bxox[abposr]-[0-9A-Za-z-]{10,}`),
+	},
+	{
+		kind:   "a JSON Web Token",
+		regexp: regexp.MustCompile(`\beyJ[0-9A-Za-z_-]{5,}\.eyJ[0-9A-Za-z_-]{5,}\.[0-9A-Za-z_-]*`),
+	},
+	{
+		kind:   "a PEM private key",
+		regexp: regexp.MustCompile(`-----BEGIN[A-Z ]* PRIVATE KEY-----`),
+	},
+}
+
+// tokenRegexp matches candidate tokens for the entropy check, such as base64
+// or hexadecimal encoded values.
+var tokenRegexp = regexp.MustCompile(`[0-9A-Za-z+/_-]{20,}={0,2}`)
+
+// uuidRegexp matches UUIDs, which are identifiers rather than credentials.
+var uuidRegexp = regexp.MustCompile(`^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`)
+
+// Options are the options of the secret validators.
+type Options struct {
+	// EntropyThreshold is the Shannon entropy, in bits per character, from
+	// which tokens of at least 20 characters are reported. The threshold is
+	// scaled down for tokens shorter than 32 characters, whose entropy is
+	// bounded by the logarithm of their length. Zero uses
+	// DefaultEntropyThreshold and a negative value disables the check.
+	EntropyThreshold float64
+
+	// Severity is the severity of diagnostics. Zero uses
+	// diag.SeverityWarning.
+	Severity diag.Severity
+}
+
+// Validate returns an error if the options are invalid.
+func (o Options) Validate() error {
+	if math.IsNaN(o.EntropyThreshold) {
+		return errors.New("EntropyThreshold cannot be NaN")
+	}
+
+	switch o.Severity {
+	case diag.SeverityInvalid, diag.SeverityWarning, diag.SeverityError:
+		return nil
+	default:
+		return fmt.Errorf("the Severity option must be diag.SeverityWarning or diag.SeverityError, got: %d", o.Severity)
+	}
+}
+
+// Diagnostics returns a diagnostic for each finding in the value.
+func Diagnostics(p path.Path, value string, opts Options) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	threshold := opts.EntropyThreshold
+
+	if threshold == 0 {
+		threshold = DefaultEntropyThreshold
+	}
+
+	for _, finding := range Scan(value, threshold) {
+		diags.Append(validatordiag.PossibleSecretDiagnostic(p, opts.Severity, finding.Kind, finding.Fragment))
+	}
+
+	return diags
+}
+
+// Scan returns the parts of the value which resemble credentials. Tokens of
+// at least 20 characters with a Shannon entropy of at least entropyThreshold
+// bits per character, scaled down for tokens shorter than 32 characters, are
+// also reported, unless entropyThreshold is negative.
+func Scan(value string, entropyThreshold float64) []Finding {
+	var findings []Finding
+	var matched [][]int
+
+	for _, p := range patterns {
+		for _, loc := range p.regexp.FindAllStringIndex(value, -1) {
+			findings = append(findings, Finding{
+				Kind:     p.kind,
+				Fragment: Mask(value[loc[0]:loc[1]]),
+			})
+			matched = append(matched, loc)
+		}
+	}
+
+	if entropyThreshold < 0 {
+		return findings
+	}
+
+	for _, loc := range tokenRegexp.FindAllStringIndex(value, -1) {
+		if overlaps(loc, matched) {
+			continue
+		}
+
+		token := value[loc[0]:loc[1]]
+
+		if !isEntropyCandidate(token) || Entropy(token) < tokenEntropyThreshold(token, entropyThreshold) {
+			continue
+		}
+
+		findings = append(findings, Finding{
+			Kind:     "a high-entropy token",
+			Fragment: Mask(token),
+		})
+	}
+
+	return findings
+}
+
+// Entropy returns the Shannon entropy of the value in bits per character.
+func Entropy(value string) float64 {
+	length := utf8.RuneCountInString(value)
+
+	if length == 0 {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+
+	for _, r := range value {
+		counts[r]++
+	}
+
+	var entropy float64
+
+	for _, count := range counts {
+		p := float64(count) / float64(length)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
+
+// tokenEntropyThreshold returns the entropy threshold for the token, which is
+// scaled down for tokens shorter than scaledEntropyTokenLength characters.
+func tokenEntropyThreshold(token string, entropyThreshold float64) float64 {
+	length := utf8.RuneCountInString(token)
+
+	if length >= scaledEntropyTokenLength {
+		return entropyThreshold
+	}
+
+	return entropyThreshold * math.Log2(float64(length)) / math.Log2(scaledEntropyTokenLength)
+}
+
+// Mask returns the leading characters of the value followed by asterisks.
+// The number of asterisks does not depend on the value length.
+func Mask(value string) string {
+	prefix := value
+
+	if utf8.RuneCountInString(value) > maskPrefixLength {
+		prefix = string([]rune(value)[:maskPrefixLength])
+	}
+
+	return fmt.Sprintf("%q", prefix+"****")
+}
+
+// isEntropyCandidate reports whether the token may be a credential, rather
+// than a word, number, or UUID.
+func isEntropyCandidate(token string) bool {
+	if utf8.RuneCountInString(token) < minEntropyTokenLength || uuidRegexp.MatchString(token) {
+		return false
+	}
+
+	return strings.IndexFunc(token, unicode.IsLetter) >= 0 && strings.IndexFunc(token, unicode.IsDigit) >= 0
+}
+
+// overlaps reports whether the location overlaps any of the matched
+// locations.
+func overlaps(loc []int, matched [][]int) bool {
+	for _, m := range matched {
+		if loc[0] < m[1] && m[0] < loc[1] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package testvalidator
+
+import (
+	"math/big"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// ConstraintCmpOptions are the go-cmp options to compare
+// validatorspec.Constraint values, which compare Min and Max by value.
+var ConstraintCmpOptions = cmp.Options{
+	cmp.Comparer(func(x, y *big.Float) bool {
+		if x == nil || y == nil {
+			return x == y
+		}
+
+		return x.Cmp(y) == 0
+	}),
+}
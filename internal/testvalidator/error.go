@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package testvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ErrorAction returns a validator which returns an error diagnostic.
+func ErrorAction(summary string, detail string) action.ConfigValidator {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorBool returns a validator which returns an error diagnostic.
+func ErrorBool(summary string, detail string) validator.Bool {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorDataSource returns a validator which returns an error diagnostic.
+func ErrorDataSource(summary string, detail string) datasource.ConfigValidator {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorDynamic returns a validator which returns an error diagnostic.
+func ErrorDynamic(summary string, detail string) validator.Dynamic {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorEphemeralResource returns a validator which returns an error diagnostic.
+func ErrorEphemeralResource(summary string, detail string) ephemeral.ConfigValidator {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorFloat32 returns a validator which returns an error diagnostic.
+func ErrorFloat32(summary string, detail string) validator.Float32 {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorFloat64 returns a validator which returns an error diagnostic.
+func ErrorFloat64(summary string, detail string) validator.Float64 {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorInt32 returns a validator which returns an error diagnostic.
+func ErrorInt32(summary string, detail string) validator.Int32 {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorInt64 returns a validator which returns an error diagnostic.
+func ErrorInt64(summary string, detail string) validator.Int64 {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorList returns a validator which returns an error diagnostic.
+func ErrorList(summary string, detail string) validator.List {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorListResourceConfig returns a validator which returns an error diagnostic.
+func ErrorListResourceConfig(summary string, detail string) list.ConfigValidator {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorMap returns a validator which returns an error diagnostic.
+func ErrorMap(summary string, detail string) validator.Map {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorNumber returns a validator which returns an error diagnostic.
+func ErrorNumber(summary string, detail string) validator.Number {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorObject returns a validator which returns an error diagnostic.
+func ErrorObject(summary string, detail string) validator.Object {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorProvider returns a validator which returns an error diagnostic.
+func ErrorProvider(summary string, detail string) provider.ConfigValidator {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorResource returns a validator which returns an error diagnostic.
+func ErrorResource(summary string, detail string) resource.ConfigValidator {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorSet returns a validator which returns an error diagnostic.
+func ErrorSet(summary string, detail string) validator.Set {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+// ErrorString returns a validator which returns an error diagnostic.
+func ErrorString(summary string, detail string) validator.String {
+	return ErrorValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
+var (
+	_ function.BoolParameterValidator    = ErrorValidator{}
+	_ function.DynamicParameterValidator = ErrorValidator{}
+	_ function.Float32ParameterValidator = ErrorValidator{}
+	_ function.Float64ParameterValidator = ErrorValidator{}
+	_ function.Int32ParameterValidator   = ErrorValidator{}
+	_ function.Int64ParameterValidator   = ErrorValidator{}
+	_ function.ListParameterValidator    = ErrorValidator{}
+	_ function.MapParameterValidator     = ErrorValidator{}
+	_ function.NumberParameterValidator  = ErrorValidator{}
+	_ function.ObjectParameterValidator  = ErrorValidator{}
+	_ function.SetParameterValidator     = ErrorValidator{}
+	_ function.StringParameterValidator  = ErrorValidator{}
+	_ action.ConfigValidator             = ErrorValidator{}
+	_ datasource.ConfigValidator         = ErrorValidator{}
+	_ ephemeral.ConfigValidator          = ErrorValidator{}
+	_ list.ConfigValidator               = ErrorValidator{}
+	_ provider.ConfigValidator           = ErrorValidator{}
+	_ resource.ConfigValidator           = ErrorValidator{}
+	_ validator.Bool                     = ErrorValidator{}
+	_ validator.Dynamic                  = ErrorValidator{}
+	_ validator.Float32                  = ErrorValidator{}
+	_ validator.Float64                  = ErrorValidator{}
+	_ validator.Int32                    = ErrorValidator{}
+	_ validator.Int64                    = ErrorValidator{}
+	_ validator.List                     = ErrorValidator{}
+	_ validator.Map                      = ErrorValidator{}
+	_ validator.Number                   = ErrorValidator{}
+	_ validator.Object                   = ErrorValidator{}
+	_ validator.Set                      = ErrorValidator{}
+	_ validator.String                   = ErrorValidator{}
+)
+
+// ErrorValidator returns an error diagnostic. Schema validators return the
+// diagnostic with the request path and function parameter validators return
+// an argument error with the summary and detail.
+type ErrorValidator struct {
+	Summary string
+	Detail  string
+}
+
+func (v ErrorValidator) Description(_ context.Context) string {
+	return "always returns an error diagnostic"
+}
+
+func (v ErrorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ErrorValidator) ValidateAction(ctx context.Context, request action.ValidateConfigRequest, response *action.ValidateConfigResponse) {
+	response.Diagnostics.AddError(v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateBool(ctx context.Context, request validator.BoolRequest, response *validator.BoolResponse) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateDataSource(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	response.Diagnostics.AddError(v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateDynamic(ctx context.Context, request validator.DynamicRequest, response *validator.DynamicResponse) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateEphemeralResource(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	response.Diagnostics.AddError(v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateFloat32(ctx context.Context, request validator.Float32Request, response *validator.Float32Response) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateFloat64(ctx context.Context, request validator.Float64Request, response *validator.Float64Response) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateInt32(ctx context.Context, request validator.Int32Request, response *validator.Int32Response) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateInt64(ctx context.Context, request validator.Int64Request, response *validator.Int64Response) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateListResourceConfig(ctx context.Context, request list.ValidateConfigRequest, response *list.ValidateConfigResponse) {
+	response.Diagnostics.AddError(v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateMap(ctx context.Context, request validator.MapRequest, response *validator.MapResponse) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateNumber(ctx context.Context, request validator.NumberRequest, response *validator.NumberResponse) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateObject(ctx context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateProvider(ctx context.Context, request provider.ValidateConfigRequest, response *provider.ValidateConfigResponse) {
+	response.Diagnostics.AddError(v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	response.Diagnostics.AddError(v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateSet(ctx context.Context, request validator.SetRequest, response *validator.SetResponse) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	response.Diagnostics.AddAttributeError(request.Path, v.Summary, v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterBool(ctx context.Context, request function.BoolParameterValidatorRequest, response *function.BoolParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterDynamic(ctx context.Context, request function.DynamicParameterValidatorRequest, response *function.DynamicParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterFloat32(ctx context.Context, request function.Float32ParameterValidatorRequest, response *function.Float32ParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterFloat64(ctx context.Context, request function.Float64ParameterValidatorRequest, response *function.Float64ParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterInt32(ctx context.Context, request function.Int32ParameterValidatorRequest, response *function.Int32ParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterInt64(ctx context.Context, request function.Int64ParameterValidatorRequest, response *function.Int64ParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterList(ctx context.Context, request function.ListParameterValidatorRequest, response *function.ListParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterMap(ctx context.Context, request function.MapParameterValidatorRequest, response *function.MapParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterNumber(ctx context.Context, request function.NumberParameterValidatorRequest, response *function.NumberParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterObject(ctx context.Context, request function.ObjectParameterValidatorRequest, response *function.ObjectParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterSet(ctx context.Context, request function.SetParameterValidatorRequest, response *function.SetParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
+
+func (v ErrorValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	response.Error = function.NewArgumentFuncError(request.ArgumentPosition, v.Summary+": "+v.Detail)
+}
@@ -39,6 +39,14 @@ func WarningDataSource(summary string, detail string) datasource.ConfigValidator
 	}
 }
 
+// WarningDynamic returns a validator which returns a warning diagnostic.
+func WarningDynamic(summary string, detail string) validator.Dynamic {
+	return WarningValidator{
+		Summary: summary,
+		Detail:  detail,
+	}
+}
+
 // WarningFloat32 returns a validator which returns a warning diagnostic.
 func WarningFloat32(summary string, detail string) validator.Float32 {
 	return WarningValidator{
@@ -156,6 +164,7 @@ var (
 	_ provider.ConfigValidator   = WarningValidator{}
 	_ resource.ConfigValidator   = WarningValidator{}
 	_ validator.Bool             = WarningValidator{}
+	_ validator.Dynamic          = WarningValidator{}
 	_ validator.Float32          = WarningValidator{}
 	_ validator.Float64          = WarningValidator{}
 	_ validator.Int32            = WarningValidator{}
@@ -193,6 +202,10 @@ func (v WarningValidator) ValidateDataSource(ctx context.Context, request dataso
 	response.Diagnostics.AddWarning(v.Summary, v.Detail)
 }
 
+func (v WarningValidator) ValidateDynamic(ctx context.Context, request validator.DynamicRequest, response *validator.DynamicResponse) {
+	response.Diagnostics.AddWarning(v.Summary, v.Detail)
+}
+
 func (v WarningValidator) ValidateEphemeralResource(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
 	response.Diagnostics.AddWarning(v.Summary, v.Detail)
 }
@@ -0,0 +1,7 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timezone provides the names of the IANA Time Zone database embedded
+// in Go by the time/tzdata package, for the IsTimeZone validator of the
+// stringvalidator package.
+package timezone
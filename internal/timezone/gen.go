@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build ignore
+
+// This program generates names.go from the time zone database of the Go
+// installation running it, which is the database embedded by time/tzdata.
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+func main() {
+	output, err := exec.Command("go", "env", "GOROOT").Output()
+
+	if err != nil {
+		log.Fatalf("error finding GOROOT: %s", err)
+	}
+
+	dir := filepath.Join(strings.TrimSpace(string(output)), "lib", "time")
+
+	script, err := os.ReadFile(filepath.Join(dir, "update.bash"))
+
+	if err != nil {
+		log.Fatalf("error reading database version: %s", err)
+	}
+
+	version := regexp.MustCompile(`(?m)^DATA=(\S+)$`).FindSubmatch(script)
+
+	if version == nil {
+		log.Fatal("error reading database version: DATA not found")
+	}
+
+	archive, err := zip.OpenReader(filepath.Join(dir, "zoneinfo.zip"))
+
+	if err != nil {
+		log.Fatalf("error opening database: %s", err)
+	}
+
+	defer archive.Close()
+
+	var names []string
+
+	for _, file := range archive.File {
+		if !file.FileInfo().IsDir() {
+			names = append(names, file.Name)
+		}
+	}
+
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+
+	fmt.Fprint(&buf, "// Copyright IBM Corp. 2022, 2026\n// SPDX-License-Identifier: MPL-2.0\n\n")
+	fmt.Fprintf(&buf, "// Code generated by gen.go from the %s time zone database; DO NOT EDIT.\n\n", version[1])
+	fmt.Fprint(&buf, "package timezone\n\n")
+	fmt.Fprintf(&buf, "// Version is the version of the time zone database.\nconst Version = %q\n\n", version[1])
+	fmt.Fprint(&buf, "var names = map[string]struct{}{\n")
+
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: {},\n", name)
+	}
+
+	fmt.Fprint(&buf, "}\n")
+
+	source, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting names.go: %s", err)
+	}
+
+	if err := os.WriteFile("names.go", source, 0o644); err != nil {
+		log.Fatalf("error writing names.go: %s", err)
+	}
+}
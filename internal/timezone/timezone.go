@@ -0,0 +1,15 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timezone
+
+//go:generate go run gen.go
+
+// IsName returns true if name is the name of a time zone in the database, as
+// given to time.LoadLocation, such as "Europe/Berlin" or "UTC". The names do
+// not depend on the time zone data of the system running the provider.
+func IsName(name string) bool {
+	_, ok := names[name]
+
+	return ok
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package timezone
+
+import (
+	"testing"
+)
+
+func TestIsName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]bool{
+		"America/New_York":           true,
+		"Europe/Berlin":              true,
+		"UTC":                        true,
+		"":                           false,
+		"Local":                      false,
+		"america/new_york":           false,
+		"posix/Europe/Berlin":        false,
+		"right/Europe/Berlin":        false,
+		"Mars/Olympus Mons":          false,
+		"../../etc/passwd":           false,
+		"America/New_York/Manhattan": false,
+	}
+
+	for name, expected := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsName(name); got != expected {
+				t.Errorf("expected %t, got: %t", expected, got)
+			}
+		})
+	}
+}
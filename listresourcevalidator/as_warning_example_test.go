@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listresourcevalidator"
+)
+
+func ExampleAsWarning() {
+	// Used inside a list.ListResource type ConfigValidators method
+	_ = []list.ConfigValidator{
+		// Return warnings, rather than errors, while practitioners
+		// update configurations before the validation is enforced.
+		listresourcevalidator.AsWarning(
+			listresourcevalidator.All( /* ... */ ),
+		),
+	}
+}
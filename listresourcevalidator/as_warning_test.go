@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresourcevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listresourcevalidator"
+)
+
+func TestAsWarningValidatorValidateListResourceConfig(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator list.ConfigValidator
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorListResourceConfig("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"multiple-errors": {
+			validator: listresourcevalidator.All(
+				testvalidator.ErrorListResourceConfig("Error Summary", "first detail"),
+				testvalidator.ErrorListResourceConfig("Error Summary", "second detail"),
+			),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"first detail",
+				),
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"second detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: listresourcevalidator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := list.ValidateConfigRequest{}
+			response := list.ValidateConfigResponse{}
+			listresourcevalidator.AsWarning(test.validator).ValidateListResourceConfig(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAsWarningValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := listresourcevalidator.AsWarning(testvalidator.ErrorListResourceConfig("Error Summary", "error detail"))
+
+	if got, expected := v.Description(context.Background()), "always returns an error diagnostic"; got != expected {
+		t.Errorf("expected description %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listresourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func ExampleSequence() {
+	// Used inside a list.ListResource type ConfigValidators method
+	_ = []list.ConfigValidator{
+		// The configuration must satisfy each validator in order,
+		// stopping at the first validator which returns an error.
+		listresourcevalidator.Sequence( /* ... */ ),
+	}
+}
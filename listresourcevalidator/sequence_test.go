@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresourcevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listresourcevalidator"
+)
+
+func TestSequenceValidatorValidateListResourceConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators []list.ConfigValidator
+		expected   diag.Diagnostics
+	}{
+		"no-validators": {},
+		"warnings": {
+			validators: []list.ConfigValidator{
+				testvalidator.WarningListResourceConfig("Warning 1", "warning 1 detail"),
+				testvalidator.WarningListResourceConfig("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewWarningDiagnostic("Warning 2", "warning 2 detail"),
+			},
+		},
+		"error-stops": {
+			validators: []list.ConfigValidator{
+				testvalidator.WarningListResourceConfig("Warning 1", "warning 1 detail"),
+				testvalidator.ErrorListResourceConfig("Error 1", "error 1 detail"),
+				testvalidator.ErrorListResourceConfig("Error 2", "error 2 detail"),
+				testvalidator.WarningListResourceConfig("Warning 2", "warning 2 detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning 1", "warning 1 detail"),
+				diag.NewErrorDiagnostic("Error 1", "error 1 detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &list.ValidateConfigResponse{}
+
+			listresourcevalidator.Sequence(testCase.validators...).ValidateListResourceConfig(context.Background(), list.ValidateConfigRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSequenceValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := listresourcevalidator.Sequence(
+		testvalidator.WarningListResourceConfig("Warning 1", "warning 1 detail"),
+		testvalidator.ErrorListResourceConfig("Error 1", "error 1 detail"),
+	)
+
+	expected := "Value must satisfy each of the validations in order: always returns a warning diagnostic then always returns an error diagnostic"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
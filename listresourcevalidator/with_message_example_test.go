@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listresourcevalidator"
+)
+
+func ExampleWithMessage() {
+	// Used inside a list.ListResource type ConfigValidators method
+	_ = []list.ConfigValidator{
+		// Replace the error diagnostic wording with domain-specific
+		// guidance for practitioners.
+		listresourcevalidator.WithMessage(
+			listresourcevalidator.All( /* ... */ ),
+			"Invalid Example Configuration",
+			"{{.Detail}} See the provider documentation for supported combinations.",
+		),
+	}
+}
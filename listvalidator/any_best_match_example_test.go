@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ExampleAnyBestMatch() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					// Validate this List value must either be:
+					//  - Empty
+					//  - At least 2 unique elements
+					// Otherwise, only the errors of the closest match are
+					// returned.
+					listvalidator.AnyBestMatch(
+						listvalidator.SizeAtMost(0),
+						listvalidator.All(
+							listvalidator.SizeAtLeast(2),
+							listvalidator.UniqueValues(),
+						),
+					),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.List{
+		"NoNullValues":    listvalidator.NoNullValues(),
+		"SizeAtLeast":     listvalidator.SizeAtLeast(1),
+		"SizeAtMost":      listvalidator.SizeAtMost(2),
+		"SizeBetween":     listvalidator.SizeBetween(1, 2),
+		"ValueStringsAre": listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		"All":             listvalidator.All(listvalidator.SizeAtLeast(1), listvalidator.SizeAtMost(2)),
+		"AsWarning":       listvalidator.AsWarning(listvalidator.SizeAtLeast(1)),
+		"AnyBestMatch":    listvalidator.AnyBestMatch(listvalidator.SizeAtLeast(1)),
+		"Sequence":        listvalidator.Sequence(listvalidator.SizeAtLeast(1)),
+		"Sensitive":       listvalidator.Sensitive(listvalidator.SizeAtLeast(1)),
+		"WithMessage":     listvalidator.WithMessage(listvalidator.SizeAtLeast(1), "Summary", "{{.Detail}}"),
+		"UniqueValues":    listvalidator.UniqueValues(),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func FuzzSizeAtLeast(f *testing.F) {
+	validatortest.FuzzList(f, listvalidator.SizeAtLeast(2))
+}
+
+func FuzzSizeAtMost(f *testing.F) {
+	validatortest.FuzzList(f, listvalidator.SizeAtMost(2))
+}
+
+func FuzzSizeBetween(f *testing.F) {
+	validatortest.FuzzList(f, listvalidator.SizeBetween(2, 4))
+}
+
+func FuzzNoNullValues(f *testing.F) {
+	validatortest.FuzzList(f, listvalidator.NoNullValues())
+}
+
+func FuzzUniqueValues(f *testing.F) {
+	validatortest.FuzzList(f, listvalidator.UniqueValues())
+}
+
+func FuzzValueStringsAre(f *testing.F) {
+	validatortest.FuzzList(f, listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 10)))
+}
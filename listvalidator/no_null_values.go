@@ -8,10 +8,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.List = noNullValuesValidator{}
 var _ function.ListParameterValidator = noNullValuesValidator{}
+var _ validatorspec.ValidatorWithConstraint = noNullValuesValidator{}
 
 type noNullValuesValidator struct{}
 
@@ -20,7 +24,13 @@ func (v noNullValuesValidator) Description(_ context.Context) string {
 }
 
 func (v noNullValuesValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v noNullValuesValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindNoNullValues,
+	}
 }
 
 func (v noNullValuesValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
@@ -6,16 +6,20 @@ package listvalidator
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.List = sizeBetweenValidator{}
 var _ function.ListParameterValidator = sizeBetweenValidator{}
+var _ validatorspec.ValidatorWithConstraint = sizeBetweenValidator{}
 
 type sizeBetweenValidator struct {
 	min int
@@ -27,7 +31,15 @@ func (v sizeBetweenValidator) Description(_ context.Context) string {
 }
 
 func (v sizeBetweenValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v sizeBetweenValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindSize,
+		Min:  new(big.Float).SetInt64(int64(v.min)),
+		Max:  new(big.Float).SetInt64(int64(v.max)),
+	}
 }
 
 func (v sizeBetweenValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
@@ -9,10 +9,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.List = uniqueValuesValidator{}
 var _ function.ListParameterValidator = uniqueValuesValidator{}
+var _ validatorspec.ValidatorWithConstraint = uniqueValuesValidator{}
 
 type uniqueValuesValidator struct{}
 
@@ -21,7 +25,13 @@ func (v uniqueValuesValidator) Description(_ context.Context) string {
 }
 
 func (v uniqueValuesValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v uniqueValuesValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindUniqueValues,
+	}
 }
 
 func (v uniqueValuesValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
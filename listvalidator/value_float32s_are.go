@@ -10,6 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // ValueFloat32sAre returns an validator which ensures that any configured
@@ -23,6 +26,7 @@ func ValueFloat32sAre(elementValidators ...validator.Float32) validator.List {
 }
 
 var _ validator.List = valueFloat32sAreValidator{}
+var _ validatorspec.ValidatorWithConstraint = valueFloat32sAreValidator{}
 
 // valueFloat32sAreValidator validates that each Float32 member validates against each of the value validators.
 type valueFloat32sAreValidator struct {
@@ -42,7 +46,15 @@ func (v valueFloat32sAreValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v valueFloat32sAreValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v valueFloat32sAreValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindElements,
+		Children: validatorspec.OfAll(ctx, v.elementValidators),
+	}
 }
 
 // ValidateFloat32 performs the validation.
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Map{
+		"NoNullValues":        mapvalidator.NoNullValues(),
+		"SizeAtLeast":         mapvalidator.SizeAtLeast(1),
+		"SizeAtMost":          mapvalidator.SizeAtMost(2),
+		"SizeBetween":         mapvalidator.SizeBetween(1, 2),
+		"ValueStringsAre":     mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		"All":                 mapvalidator.All(mapvalidator.SizeAtLeast(1), mapvalidator.SizeAtMost(2)),
+		"AsWarning":           mapvalidator.AsWarning(mapvalidator.SizeAtLeast(1)),
+		"AnyBestMatch":        mapvalidator.AnyBestMatch(mapvalidator.SizeAtLeast(1)),
+		"Sequence":            mapvalidator.Sequence(mapvalidator.SizeAtLeast(1)),
+		"Sensitive":           mapvalidator.Sensitive(mapvalidator.SizeAtLeast(1)),
+		"WithMessage":         mapvalidator.WithMessage(mapvalidator.SizeAtLeast(1), "Summary", "{{.Detail}}"),
+		"KeysAre":             mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+		"ValuesHaveNoSecrets": mapvalidator.ValuesHaveNoSecrets(),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: mapvalidator.All(mapvalidator.SizeAtLeast(1), mapvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: mapvalidator.Any(mapvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: mapvalidator.AnyBestMatch(mapvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: mapvalidator.AnyWithAllWarnings(mapvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: mapvalidator.AsWarning(mapvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"Sensitive": {
+			validator: mapvalidator.Sensitive(mapvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSize,
+				Min:  new(big.Float).SetInt64(1),
+			},
+		},
+		"Sequence": {
+			validator: mapvalidator.Sequence(mapvalidator.SizeAtLeast(1), mapvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: mapvalidator.WithMessage(mapvalidator.SizeAtLeast(1), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSize,
+				Min:  new(big.Float).SetInt64(1),
+			},
+		},
+		"SizeAtMost": {
+			validator: mapvalidator.SizeAtMost(2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSize,
+				Max:  new(big.Float).SetInt64(2),
+			},
+		},
+		"SizeBetween": {
+			validator: mapvalidator.SizeBetween(1, 2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSize,
+				Min:  new(big.Float).SetInt64(1),
+				Max:  new(big.Float).SetInt64(2),
+			},
+		},
+		"NoNullValues": {
+			validator: mapvalidator.NoNullValues(),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindNoNullValues,
+			},
+		},
+		"KeysAre": {
+			validator: mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindKeys,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"ValuesHaveNoSecrets": {
+			validator: mapvalidator.ValuesHaveNoSecrets(),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindNoSecrets,
+					},
+				},
+			},
+		},
+		"ValueFloat32sAre": {
+			validator: mapvalidator.ValueFloat32sAre(float32validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"ValueFloat64sAre": {
+			validator: mapvalidator.ValueFloat64sAre(float64validator.AtLeast(1.5)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  big.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		"ValueInt32sAre": {
+			validator: mapvalidator.ValueInt32sAre(int32validator.AtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"ValueInt64sAre": {
+			validator: mapvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindRange,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"ValueListsAre": {
+			validator: mapvalidator.ValueListsAre(listvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"ValueMapsAre": {
+			validator: mapvalidator.ValueMapsAre(mapvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"ValueNumbersAre": {
+			validator: mapvalidator.ValueNumbersAre(numbervalidator.OneOf(big.NewFloat(1))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindOneOf,
+						Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+					},
+				},
+			},
+		},
+		"ValueSetsAre": {
+			validator: mapvalidator.ValueSetsAre(setvalidator.SizeAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindSize,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"ValueStringsAre": {
+			validator: mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindElements,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
@@ -10,9 +10,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.Map = keysAreValidator{}
+var _ validatorspec.ValidatorWithConstraint = keysAreValidator{}
 
 // keysAreValidator validates that each map key validates against each of the value validators.
 type keysAreValidator struct {
@@ -31,7 +35,15 @@ func (v keysAreValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v keysAreValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v keysAreValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindKeys,
+		Children: validatorspec.OfAll(ctx, v.keyValidators),
+	}
 }
 
 // ValidateMap performs the validation.
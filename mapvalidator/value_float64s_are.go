@@ -10,6 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // ValueFloat64sAre returns an validator which ensures that any configured
@@ -23,6 +26,7 @@ func ValueFloat64sAre(elementValidators ...validator.Float64) validator.Map {
 }
 
 var _ validator.Map = valueFloat64sAreValidator{}
+var _ validatorspec.ValidatorWithConstraint = valueFloat64sAreValidator{}
 
 // valueFloat64sAreValidator validates that each Float64 member validates against each of the value validators.
 type valueFloat64sAreValidator struct {
@@ -42,7 +46,15 @@ func (v valueFloat64sAreValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v valueFloat64sAreValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v valueFloat64sAreValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindElements,
+		Children: validatorspec.OfAll(ctx, v.elementValidators),
+	}
 }
 
 // ValidateFloat64 performs the validation.
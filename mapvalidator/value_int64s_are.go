@@ -10,6 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // ValueInt64sAre returns an validator which ensures that any configured
@@ -23,6 +26,7 @@ func ValueInt64sAre(elementValidators ...validator.Int64) validator.Map {
 }
 
 var _ validator.Map = valueInt64sAreValidator{}
+var _ validatorspec.ValidatorWithConstraint = valueInt64sAreValidator{}
 
 // valueInt64sAreValidator validates that each Int64 member validates against each of the value validators.
 type valueInt64sAreValidator struct {
@@ -42,7 +46,15 @@ func (v valueInt64sAreValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v valueInt64sAreValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v valueInt64sAreValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindElements,
+		Children: validatorspec.OfAll(ctx, v.elementValidators),
+	}
 }
 
 // ValidateInt64 performs the validation.
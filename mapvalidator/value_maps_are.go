@@ -10,6 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // ValueMapsAre returns an validator which ensures that any configured
@@ -23,6 +26,7 @@ func ValueMapsAre(elementValidators ...validator.Map) validator.Map {
 }
 
 var _ validator.Map = valueMapsAreValidator{}
+var _ validatorspec.ValidatorWithConstraint = valueMapsAreValidator{}
 
 // valueMapsAreValidator validates that each Map member validates against each of the value validators.
 type valueMapsAreValidator struct {
@@ -42,7 +46,15 @@ func (v valueMapsAreValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v valueMapsAreValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v valueMapsAreValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindElements,
+		Children: validatorspec.OfAll(ctx, v.elementValidators),
+	}
 }
 
 // ValidateMap performs the validation.
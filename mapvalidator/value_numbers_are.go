@@ -10,6 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // ValueNumbersAre returns an validator which ensures that any configured
@@ -23,6 +26,7 @@ func ValueNumbersAre(elementValidators ...validator.Number) validator.Map {
 }
 
 var _ validator.Map = valueNumbersAreValidator{}
+var _ validatorspec.ValidatorWithConstraint = valueNumbersAreValidator{}
 
 // valueNumbersAreValidator validates that each Number member validates against each of the value validators.
 type valueNumbersAreValidator struct {
@@ -42,7 +46,15 @@ func (v valueNumbersAreValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v valueNumbersAreValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v valueNumbersAreValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindElements,
+		Children: validatorspec.OfAll(ctx, v.elementValidators),
+	}
 }
 
 // ValidateNumber performs the validation.
@@ -10,6 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // ValueSetsAre returns an validator which ensures that any configured
@@ -23,6 +26,7 @@ func ValueSetsAre(elementValidators ...validator.Set) validator.Map {
 }
 
 var _ validator.Map = valueSetsAreValidator{}
+var _ validatorspec.ValidatorWithConstraint = valueSetsAreValidator{}
 
 // valueSetsAreValidator validates that each set member validates against each of the value validators.
 type valueSetsAreValidator struct {
@@ -42,7 +46,15 @@ func (v valueSetsAreValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v valueSetsAreValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v valueSetsAreValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindElements,
+		Children: validatorspec.OfAll(ctx, v.elementValidators),
+	}
 }
 
 // ValidateSet performs the validation.
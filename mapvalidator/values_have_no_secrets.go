@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/secretscan"
+)
+
+// NoSecretsOptions are the options of the ValuesHaveNoSecretsWithOptions
+// validator. Zero values use the defaults.
+type NoSecretsOptions struct {
+	// EntropyThreshold is the Shannon entropy, in bits per character, from
+	// which tokens of at least 20 characters, such as base64 encoded keys,
+	// are reported. The threshold is scaled down for tokens shorter than 32
+	// characters, whose entropy cannot reach it. Defaults to 4.5. A negative
+	// value disables the check.
+	EntropyThreshold float64
+
+	// Severity is the severity of diagnostics, either diag.SeverityWarning
+	// or diag.SeverityError. Defaults to diag.SeverityWarning.
+	Severity diag.Severity
+}
+
+// ValuesHaveNoSecrets returns a validator which warns when any configured
+// String value of the map resembles a credential, such as a cloud access
+// key, a JSON Web Token, a PEM private key, or a high-entropy token.
+//
+// This is intended for attributes which are not sensitive, such as tags,
+// where credentials would be shown in plan output and stored in state.
+// Diagnostics only include a masked fragment of the matching part of the
+// value.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ValuesHaveNoSecrets() validator.Map {
+	return ValuesHaveNoSecretsWithOptions(NoSecretsOptions{})
+}
+
+// ValuesHaveNoSecretsWithOptions returns a ValuesHaveNoSecrets validator with
+// the given entropy threshold and diagnostic severity.
+func ValuesHaveNoSecretsWithOptions(opts NoSecretsOptions) validator.Map {
+	return valuesHaveNoSecretsValidator{
+		opts: secretscan.Options{
+			EntropyThreshold: opts.EntropyThreshold,
+			Severity:         opts.Severity,
+		},
+	}
+}
+
+var _ validator.Map = valuesHaveNoSecretsValidator{}
+var _ validatorspec.ValidatorWithConstraint = valuesHaveNoSecretsValidator{}
+
+// valuesHaveNoSecretsValidator validates that no Map value resembles a credential.
+type valuesHaveNoSecretsValidator struct {
+	opts secretscan.Options
+}
+
+// Description describes the validation in plain text formatting.
+func (v valuesHaveNoSecretsValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("element %s", secretscan.Description)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v valuesHaveNoSecretsValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v valuesHaveNoSecretsValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindElements,
+		Children: []validatorspec.Constraint{
+			{
+				Kind:        validatorspec.KindNoSecrets,
+				Description: secretscan.Description,
+			},
+		},
+	}
+}
+
+// ValidateMap performs the validation.
+func (v valuesHaveNoSecretsValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if err := v.opts.Validate(); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidValidatorUsageDiagnostic(req.Path, "ValuesHaveNoSecretsWithOptions", err.Error()))
+
+		return
+	}
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, ok := req.ConfigValue.ElementType(ctx).(basetypes.StringTypable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Validator for Element Type",
+			"While performing schema-based validation, an unexpected error occurred. "+
+				"The attribute declares a String values validator, however its values do not implement types.StringType or the types.StringTypable interface for custom String types. "+
+				"Use the appropriate values validator that matches the element type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Path: %s\n", req.Path.String())+
+				fmt.Sprintf("Element Type: %T\n", req.ConfigValue.ElementType(ctx)),
+		)
+
+		return
+	}
+
+	for key, element := range req.ConfigValue.Elements() {
+		elementValuable, ok := element.(basetypes.StringValuable)
+
+		// The check above should have prevented this, but raise an error
+		// instead of a type assertion panic or skipping the element. Any issue
+		// here likely indicates something wrong in the framework itself.
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Validator for Element Value",
+				"While performing schema-based validation, an unexpected error occurred. "+
+					"The attribute declares a String values validator, however its values do not implement types.StringType or the types.StringTypable interface for custom String types. "+
+					"This is likely an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Path: %s\n", req.Path.String())+
+					fmt.Sprintf("Element Type: %T\n", req.ConfigValue.ElementType(ctx))+
+					fmt.Sprintf("Element Value Type: %T\n", element),
+			)
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToStringValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		// Only return early if the new diagnostics indicate an issue since
+		// it likely will be the same for all elements.
+		if diags.HasError() {
+			return
+		}
+
+		if elementValue.IsNull() || elementValue.IsUnknown() {
+			continue
+		}
+
+		resp.Diagnostics.Append(secretscan.Diagnostics(req.Path.AtMapKey(key), elementValue.ValueString(), v.opts)...)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+)
+
+func ExampleValuesHaveNoSecretsWithOptions() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					// Raise an error if any tag value resembles a credential,
+					// without checking the entropy of tokens.
+					mapvalidator.ValuesHaveNoSecretsWithOptions(mapvalidator.NoSecretsOptions{
+						EntropyThreshold: -1,
+						Severity:         diag.SeverityError,
+					}),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleAnyBestMatch() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.NumberAttribute{
+				Required: true,
+				Validators: []validator.Number{
+					// Validate this value passes at least one validator,
+					// otherwise returning only the errors of the closest match.
+					numbervalidator.AnyBestMatch( /* ... */ ),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Number{
+		"OneOf":        numbervalidator.OneOf(big.NewFloat(1), big.NewFloat(2)),
+		"NoneOf":       numbervalidator.NoneOf(big.NewFloat(1), big.NewFloat(2)),
+		"OneOfFunc":    numbervalidator.OneOfFunc(func(context.Context) ([]*big.Float, error) { return []*big.Float{big.NewFloat(1)}, nil }, numbervalidator.OneOfFuncOptions{}),
+		"All":          numbervalidator.All(numbervalidator.NoneOf(big.NewFloat(1))),
+		"AsWarning":    numbervalidator.AsWarning(numbervalidator.OneOf(big.NewFloat(1))),
+		"AnyBestMatch": numbervalidator.AnyBestMatch(numbervalidator.OneOf(big.NewFloat(1))),
+		"Sequence":     numbervalidator.Sequence(numbervalidator.OneOf(big.NewFloat(1))),
+		"Sensitive":    numbervalidator.Sensitive(numbervalidator.OneOf(big.NewFloat(1))),
+		"WithMessage":  numbervalidator.WithMessage(numbervalidator.OneOf(big.NewFloat(1)), "Summary", "{{.Detail}}"),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: numbervalidator.All(numbervalidator.OneOf(big.NewFloat(1)), numbervalidator.OneOf(big.NewFloat(1))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindOneOf,
+						Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+					},
+					{
+						Kind:   validatorspec.KindOneOf,
+						Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: numbervalidator.Any(numbervalidator.OneOf(big.NewFloat(1))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindOneOf,
+						Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: numbervalidator.AnyBestMatch(numbervalidator.OneOf(big.NewFloat(1))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindOneOf,
+						Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: numbervalidator.AnyWithAllWarnings(numbervalidator.OneOf(big.NewFloat(1))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindOneOf,
+						Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: numbervalidator.AsWarning(numbervalidator.OneOf(big.NewFloat(1))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindOneOf,
+						Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+					},
+				},
+			},
+		},
+		"Sensitive": {
+			validator: numbervalidator.Sensitive(numbervalidator.OneOf(big.NewFloat(1))),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindOneOf,
+				Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+			},
+		},
+		"Sequence": {
+			validator: numbervalidator.Sequence(numbervalidator.OneOf(big.NewFloat(1)), numbervalidator.OneOf(big.NewFloat(1))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:   validatorspec.KindOneOf,
+						Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+					},
+					{
+						Kind:   validatorspec.KindOneOf,
+						Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: numbervalidator.WithMessage(numbervalidator.OneOf(big.NewFloat(1)), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindOneOf,
+				Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+			},
+		},
+		"NoneOf": {
+			validator: numbervalidator.NoneOf(big.NewFloat(1)),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindNoneOf,
+				Values: validatorspec.Values([]types.Number{types.NumberValue(big.NewFloat(1))}),
+			},
+		},
+		"OneOfFunc": {
+			validator: numbervalidator.OneOfFunc(func(context.Context) ([]*big.Float, error) { return nil, nil }, numbervalidator.OneOfFuncOptions{}),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindOneOfFunc,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/memoize"
+)
+
+// OneOfFuncOptions configures the OneOfFunc validator.
+type OneOfFuncOptions struct {
+	// Memoize enables caching of the values returned by the source after
+	// its first successful call, rather than calling the source during
+	// every validation. Errors are never cached.
+	Memoize bool
+
+	// MemoizeTTL is the duration memoized values are reused before the
+	// source is called again. Zero reuses memoized values indefinitely.
+	MemoizeTTL time.Duration
+}
+
+var _ validator.Number = oneOfFuncValidator{}
+var _ function.NumberParameterValidator = oneOfFuncValidator{}
+var _ validatorspec.ValidatorWithConstraint = oneOfFuncValidator{}
+
+type oneOfFuncValidator struct {
+	// oneOf is the OneOf validator of the values returned by the source,
+	// which is memoized with the values.
+	oneOf *memoize.Func[oneOfValidator]
+}
+
+// Description does not call the source, as descriptions are also used
+// outside of validation, such as when generating documentation.
+func (v oneOfFuncValidator) Description(_ context.Context) string {
+	return "value must be one of the allowed values"
+}
+
+func (v oneOfFuncValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v oneOfFuncValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindOneOfFunc,
+	}
+}
+
+func (v oneOfFuncValidator) ValidateNumber(ctx context.Context, request validator.NumberRequest, response *validator.NumberResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.oneOf == nil {
+		response.Diagnostics.Append(
+			validatordiag.InvalidValidatorUsageDiagnostic(
+				request.Path,
+				"OneOfFunc",
+				"source cannot be nil",
+			),
+		)
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	oneOf, err := v.oneOf.Get(ctx)
+
+	if err != nil {
+		response.Diagnostics.Append(validatordiag.ValueSourceErrorDiagnostic(request.Path, "OneOfFunc", err))
+
+		return
+	}
+
+	oneOf.ValidateNumber(ctx, request, response)
+}
+
+func (v oneOfFuncValidator) ValidateParameterNumber(ctx context.Context, request function.NumberParameterValidatorRequest, response *function.NumberParameterValidatorResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.oneOf == nil {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			request.ArgumentPosition,
+			"OneOfFunc",
+			"source cannot be nil",
+		)
+
+		return
+	}
+
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	oneOf, err := v.oneOf.Get(ctx)
+
+	if err != nil {
+		response.Error = validatorfuncerr.ValueSourceFuncError(request.ArgumentPosition, "OneOfFunc", err)
+
+		return
+	}
+
+	oneOf.ValidateParameterNumber(ctx, request, response)
+}
+
+// OneOfFunc checks that the Number held in the attribute or function parameter
+// is one of the values returned by `source`, which is called during
+// validation rather than when the schema is defined. This supports allowed
+// values which are only known at runtime, such as values loaded from an
+// embedded file. It is otherwise equivalent to OneOf, except that
+// descriptions do not list the values, as they do not call `source`.
+//
+// By default, `source` is called during every validation. Set the Memoize
+// field of `options` to reuse the values from the first successful call. If
+// `source` returns an error, validation returns an error diagnostic including
+// it.
+//
+// A nil `source` will result in an implementation error message during
+// validation.
+func OneOfFunc(source func(context.Context) ([]*big.Float, error), options OneOfFuncOptions) oneOfFuncValidator {
+	if source == nil {
+		return oneOfFuncValidator{}
+	}
+
+	load := func(ctx context.Context) (oneOfValidator, error) {
+		values, err := source(ctx)
+
+		if err != nil {
+			return oneOfValidator{}, err
+		}
+
+		return OneOf(values...), nil
+	}
+
+	return oneOfFuncValidator{
+		oneOf: memoize.New(load, options.Memoize, options.MemoizeTTL),
+	}
+}
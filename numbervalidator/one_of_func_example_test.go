@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleOneOfFunc() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.NumberAttribute{
+				Required: true,
+				Validators: []validator.Number{
+					// Validate number value must be one of the values loaded
+					// during validation, loading them at most once
+					numbervalidator.OneOfFunc(
+						func(_ context.Context) ([]*big.Float, error) {
+							return []*big.Float{big.NewFloat(1.2), big.NewFloat(2.4)}, nil
+						},
+						numbervalidator.OneOfFuncOptions{
+							Memoize: true,
+						},
+					),
+				},
+			},
+		},
+	}
+}
+
+func ExampleOneOfFunc_function() {
+	_ = function.Definition{
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name: "example_param",
+				Validators: []function.NumberParameterValidator{
+					// Validate number value must be one of the values loaded
+					// during validation, loading them at most once
+					numbervalidator.OneOfFunc(
+						func(_ context.Context) ([]*big.Float, error) {
+							return []*big.Float{big.NewFloat(1.2), big.NewFloat(2.4)}, nil
+						},
+						numbervalidator.OneOfFuncOptions{
+							Memoize: true,
+						},
+					),
+				},
+			},
+		},
+	}
+}
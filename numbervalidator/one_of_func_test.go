@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator"
+)
+
+func TestOneOfFuncValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		in          types.Number
+		source      func(context.Context) ([]*big.Float, error)
+		expectError bool
+	}
+
+	values := func(_ context.Context) ([]*big.Float, error) {
+		return []*big.Float{big.NewFloat(1.2), big.NewFloat(2.4)}, nil
+	}
+
+	testCases := map[string]testCase{
+		"simple-match": {
+			in:     types.NumberValue(big.NewFloat(2.4)),
+			source: values,
+		},
+		"simple-mismatch": {
+			in:          types.NumberValue(big.NewFloat(4.8)),
+			source:      values,
+			expectError: true,
+		},
+		"source-error": {
+			in: types.NumberValue(big.NewFloat(2.4)),
+			source: func(_ context.Context) ([]*big.Float, error) {
+				return nil, errors.New("catalogue unavailable")
+			},
+			expectError: true,
+		},
+		"nil-source": {
+			in:          types.NumberValue(big.NewFloat(2.4)),
+			expectError: true,
+		},
+		"skip-validation-on-null": {
+			in:     types.NumberNull(),
+			source: values,
+		},
+		"skip-validation-on-unknown": {
+			in:     types.NumberUnknown(),
+			source: values,
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(fmt.Sprintf("ValidateNumber - %s", name), func(t *testing.T) {
+			t.Parallel()
+			req := validator.NumberRequest{
+				ConfigValue: test.in,
+			}
+			res := validator.NumberResponse{}
+			numbervalidator.OneOfFunc(test.source, numbervalidator.OneOfFuncOptions{}).ValidateNumber(context.TODO(), req, &res)
+
+			if !res.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if res.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", res.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterNumber - %s", name), func(t *testing.T) {
+			t.Parallel()
+			req := function.NumberParameterValidatorRequest{
+				Value: test.in,
+			}
+			res := function.NumberParameterValidatorResponse{}
+			numbervalidator.OneOfFunc(test.source, numbervalidator.OneOfFuncOptions{}).ValidateParameterNumber(context.TODO(), req, &res)
+
+			if res.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if res.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", res.Error)
+			}
+		})
+	}
+}
+
+func TestOneOfFuncValidator_Memoize(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		options       numbervalidator.OneOfFuncOptions
+		expectedCalls int
+	}
+
+	testCases := map[string]testCase{
+		"disabled": {
+			options:       numbervalidator.OneOfFuncOptions{},
+			expectedCalls: 3,
+		},
+		"enabled": {
+			options: numbervalidator.OneOfFuncOptions{
+				Memoize: true,
+			},
+			expectedCalls: 1,
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			v := numbervalidator.OneOfFunc(func(_ context.Context) ([]*big.Float, error) {
+				calls++
+
+				return []*big.Float{big.NewFloat(1.2), big.NewFloat(2.4)}, nil
+			}, test.options)
+
+			for range 3 {
+				req := validator.NumberRequest{
+					ConfigValue: types.NumberValue(big.NewFloat(2.4)),
+				}
+				res := validator.NumberResponse{}
+				v.ValidateNumber(context.TODO(), req, &res)
+
+				if res.Diagnostics.HasError() {
+					t.Fatalf("got unexpected error: %s", res.Diagnostics)
+				}
+			}
+
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d source calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestOneOfFuncValidator_SourceError(t *testing.T) {
+	t.Parallel()
+
+	req := validator.NumberRequest{
+		Path:        path.Root("test"),
+		ConfigValue: types.NumberValue(big.NewFloat(2.4)),
+	}
+	res := validator.NumberResponse{}
+	numbervalidator.OneOfFunc(func(_ context.Context) ([]*big.Float, error) {
+		return nil, errors.New("catalogue unavailable")
+	}, numbervalidator.OneOfFuncOptions{}).ValidateNumber(context.TODO(), req, &res)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Unable to Determine Allowed Values",
+			"When validating attribute test, the allowed values could not be determined. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				`The value source of the "OneOfFunc" validator returned an error: catalogue unavailable`,
+		),
+	}
+
+	if diff := cmp.Diff(res.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestOneOfFuncValidator_Description(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	v := numbervalidator.OneOfFunc(func(_ context.Context) ([]*big.Float, error) {
+		calls++
+
+		return nil, errors.New("catalogue unavailable")
+	}, numbervalidator.OneOfFuncOptions{})
+
+	expected := "value must be one of the allowed values"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected description difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(expected, v.MarkdownDescription(context.Background())); diff != "" {
+		t.Errorf("unexpected markdown description difference: %s", diff)
+	}
+
+	if calls != 0 {
+		t.Errorf("expected no source calls, got %d", calls)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package objectvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Object{
+		"All":          objectvalidator.All(),
+		"AsWarning":    objectvalidator.AsWarning(objectvalidator.All()),
+		"AnyBestMatch": objectvalidator.AnyBestMatch(objectvalidator.All()),
+		"Sequence":     objectvalidator.Sequence(),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
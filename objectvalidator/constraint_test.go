@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package objectvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: objectvalidator.All(objectvalidator.ConflictsWith(path.MatchRoot("other")), objectvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: objectvalidator.Any(objectvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: objectvalidator.AnyBestMatch(objectvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: objectvalidator.AnyWithAllWarnings(objectvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: objectvalidator.AsWarning(objectvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"Sensitive": {
+			validator: objectvalidator.Sensitive(objectvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindConflictsWith,
+				Paths: path.Expressions{path.MatchRoot("other")},
+			},
+		},
+		"Sequence": {
+			validator: objectvalidator.Sequence(objectvalidator.ConflictsWith(path.MatchRoot("other")), objectvalidator.ConflictsWith(path.MatchRoot("other"))),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+					{
+						Kind:  validatorspec.KindConflictsWith,
+						Paths: path.Expressions{path.MatchRoot("other")},
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: objectvalidator.WithMessage(objectvalidator.ConflictsWith(path.MatchRoot("other")), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindConflictsWith,
+				Paths: path.Expressions{path.MatchRoot("other")},
+			},
+		},
+		"AtLeastOneOf": {
+			validator: objectvalidator.AtLeastOneOf(path.MatchRoot("a")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindAtLeastOneOf,
+				Paths: path.Expressions{path.MatchRoot("a")},
+			},
+		},
+		"IsRequired": {
+			validator: objectvalidator.IsRequired(),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindRequired,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
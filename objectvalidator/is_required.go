@@ -7,10 +7,13 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var _ validator.Object = isRequiredValidator{}
+var _ validatorspec.ValidatorWithConstraint = isRequiredValidator{}
 
 // isRequiredValidator validates that an object has a configuration value.
 type isRequiredValidator struct{}
@@ -22,7 +25,14 @@ func (v isRequiredValidator) Description(_ context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v isRequiredValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v isRequiredValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindRequired,
+	}
 }
 
 // Validate performs the validation.
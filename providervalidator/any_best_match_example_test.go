@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package providervalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+)
+
+func ExampleAnyBestMatch() {
+	// Used inside a provider.Provider type ConfigValidators method
+	_ = []provider.ConfigValidator{
+		// The configuration must satisfy at least one validator,
+		// otherwise returning only the errors of the closest match.
+		providervalidator.AnyBestMatch( /* ... */ ),
+	}
+}
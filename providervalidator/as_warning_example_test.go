@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package providervalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+)
+
+func ExampleAsWarning() {
+	// Used inside a provider.Provider type ConfigValidators method
+	_ = []provider.ConfigValidator{
+		// Return warnings, rather than errors, while practitioners
+		// update configurations before the validation is enforced.
+		providervalidator.AsWarning(
+			providervalidator.All( /* ... */ ),
+		),
+	}
+}
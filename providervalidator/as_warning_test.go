@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package providervalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+)
+
+func TestAsWarningValidatorValidateProvider(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator provider.ConfigValidator
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorProvider("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"multiple-errors": {
+			validator: providervalidator.All(
+				testvalidator.ErrorProvider("Error Summary", "first detail"),
+				testvalidator.ErrorProvider("Error Summary", "second detail"),
+			),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"first detail",
+				),
+				diag.NewWarningDiagnostic(
+					"Error Summary",
+					"second detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: providervalidator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := provider.ValidateConfigRequest{}
+			response := provider.ValidateConfigResponse{}
+			providervalidator.AsWarning(test.validator).ValidateProvider(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAsWarningValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := providervalidator.AsWarning(testvalidator.ErrorProvider("Error Summary", "error detail"))
+
+	if got, expected := v.Description(context.Background()), "always returns an error diagnostic"; got != expected {
+		t.Errorf("expected description %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package providervalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+)
+
+func ExampleSequence() {
+	// Used inside a provider.Provider type ConfigValidators method
+	_ = []provider.ConfigValidator{
+		// The configuration must satisfy each validator in order,
+		// stopping at the first validator which returns an error.
+		providervalidator.Sequence( /* ... */ ),
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package providervalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+)
+
+func ExampleWithMessage() {
+	// Used inside a provider.Provider type ConfigValidators method
+	_ = []provider.ConfigValidator{
+		// Replace the error diagnostic wording with domain-specific
+		// guidance for practitioners.
+		providervalidator.WithMessage(
+			providervalidator.All( /* ... */ ),
+			"Invalid Example Configuration",
+			"{{.Detail}} See the provider documentation for supported combinations.",
+		),
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+)
+
+func ExampleAnyBestMatch() {
+	// Used inside a resource.Resource type ConfigValidators method
+	_ = []resource.ConfigValidator{
+		// The configuration must satisfy at least one validator,
+		// otherwise returning only the errors of the closest match.
+		resourcevalidator.AnyBestMatch( /* ... */ ),
+	}
+}
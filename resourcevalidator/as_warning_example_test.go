@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+)
+
+func ExampleAsWarning() {
+	// Used inside a resource.Resource type ConfigValidators method
+	_ = []resource.ConfigValidator{
+		// Return warnings, rather than errors, while practitioners
+		// update configurations before the validation is enforced.
+		resourcevalidator.AsWarning(
+			resourcevalidator.All( /* ... */ ),
+		),
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // PreferWriteOnlyAttribute returns a warning if the Terraform client supports
@@ -25,6 +28,7 @@ func PreferWriteOnlyAttribute(oldAttribute path.Expression, writeOnlyAttribute p
 }
 
 var _ resource.ConfigValidator = preferWriteOnlyAttributeValidator{}
+var _ validatorspec.ValidatorWithConstraint = preferWriteOnlyAttributeValidator{}
 
 // preferWriteOnlyAttributeValidator implements the validator.
 type preferWriteOnlyAttributeValidator struct {
@@ -39,7 +43,15 @@ func (v preferWriteOnlyAttributeValidator) Description(ctx context.Context) stri
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v preferWriteOnlyAttributeValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v preferWriteOnlyAttributeValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:  validatorspec.KindPreferWriteOnlyAttribute,
+		Paths: path.Expressions{v.oldAttribute, v.writeOnlyAttribute},
+	}
 }
 
 // ValidateResource performs the validation.
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcevalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+)
+
+func ExampleSequence() {
+	// Used inside a resource.Resource type ConfigValidators method
+	_ = []resource.ConfigValidator{
+		// The configuration must satisfy each validator in order,
+		// stopping at the first validator which returns an error.
+		resourcevalidator.Sequence( /* ... */ ),
+	}
+}
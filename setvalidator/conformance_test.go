@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package setvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.Set{
+		"NoNullValues":    setvalidator.NoNullValues(),
+		"SizeAtLeast":     setvalidator.SizeAtLeast(1),
+		"SizeAtMost":      setvalidator.SizeAtMost(2),
+		"SizeBetween":     setvalidator.SizeBetween(1, 2),
+		"ValueStringsAre": setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		"All":             setvalidator.All(setvalidator.SizeAtLeast(1), setvalidator.SizeAtMost(2)),
+		"AsWarning":       setvalidator.AsWarning(setvalidator.SizeAtLeast(1)),
+		"AnyBestMatch":    setvalidator.AnyBestMatch(setvalidator.SizeAtLeast(1)),
+		"Sequence":        setvalidator.Sequence(setvalidator.SizeAtLeast(1)),
+		"Sensitive":       setvalidator.Sensitive(setvalidator.SizeAtLeast(1)),
+		"WithMessage":     setvalidator.WithMessage(setvalidator.SizeAtLeast(1), "Summary", "{{.Detail}}"),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
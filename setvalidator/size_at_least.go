@@ -6,16 +6,20 @@ package setvalidator
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.Set = sizeAtLeastValidator{}
 var _ function.SetParameterValidator = sizeAtLeastValidator{}
+var _ validatorspec.ValidatorWithConstraint = sizeAtLeastValidator{}
 
 type sizeAtLeastValidator struct {
 	min int
@@ -26,7 +30,14 @@ func (v sizeAtLeastValidator) Description(_ context.Context) string {
 }
 
 func (v sizeAtLeastValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v sizeAtLeastValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindSize,
+		Min:  new(big.Float).SetInt64(int64(v.min)),
+	}
 }
 
 func (v sizeAtLeastValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
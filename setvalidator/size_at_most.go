@@ -6,16 +6,20 @@ package setvalidator
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.Set = sizeAtMostValidator{}
 var _ function.SetParameterValidator = sizeAtMostValidator{}
+var _ validatorspec.ValidatorWithConstraint = sizeAtMostValidator{}
 
 type sizeAtMostValidator struct {
 	max int
@@ -26,7 +30,14 @@ func (v sizeAtMostValidator) Description(_ context.Context) string {
 }
 
 func (v sizeAtMostValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v sizeAtMostValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindSize,
+		Max:  new(big.Float).SetInt64(int64(v.max)),
+	}
 }
 
 func (v sizeAtMostValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
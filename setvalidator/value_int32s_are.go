@@ -10,6 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // ValueInt32sAre returns an validator which ensures that any configured
@@ -23,6 +26,7 @@ func ValueInt32sAre(elementValidators ...validator.Int32) validator.Set {
 }
 
 var _ validator.Set = valueInt32sAreValidator{}
+var _ validatorspec.ValidatorWithConstraint = valueInt32sAreValidator{}
 
 // valueInt32sAreValidator validates that each Int32 member validates against each of the value validators.
 type valueInt32sAreValidator struct {
@@ -42,7 +46,15 @@ func (v valueInt32sAreValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v valueInt32sAreValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v valueInt32sAreValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindElements,
+		Children: validatorspec.OfAll(ctx, v.elementValidators),
+	}
 }
 
 // ValidateInt32 performs the validation.
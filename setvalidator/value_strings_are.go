@@ -10,6 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 // ValueStringsAre returns an validator which ensures that any configured
@@ -23,6 +26,7 @@ func ValueStringsAre(elementValidators ...validator.String) validator.Set {
 }
 
 var _ validator.Set = valueStringsAreValidator{}
+var _ validatorspec.ValidatorWithConstraint = valueStringsAreValidator{}
 
 // valueStringsAreValidator validates that each set member validates against each of the value validators.
 type valueStringsAreValidator struct {
@@ -42,7 +46,15 @@ func (v valueStringsAreValidator) Description(ctx context.Context) string {
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (v valueStringsAreValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+// Constraint returns the structured constraint of the validation.
+func (v valueStringsAreValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:     validatorspec.KindElements,
+		Children: validatorspec.OfAll(ctx, v.elementValidators),
+	}
 }
 
 // ValidateSet performs the validation.
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func ExampleAsWarning() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Return warnings, rather than errors, while practitioners
+					// update configurations before the validation is enforced.
+					stringvalidator.AsWarning(
+						stringvalidator.All( /* ... */ ),
+					),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]validator.String{
+		"LengthAtLeast":                      stringvalidator.LengthAtLeast(1),
+		"LengthAtMost":                       stringvalidator.LengthAtMost(10),
+		"LengthBetween":                      stringvalidator.LengthBetween(1, 10),
+		"UTF8LengthAtLeast":                  stringvalidator.UTF8LengthAtLeast(1),
+		"UTF8LengthAtMost":                   stringvalidator.UTF8LengthAtMost(10),
+		"UTF8LengthBetween":                  stringvalidator.UTF8LengthBetween(1, 10),
+		"OneOf":                              stringvalidator.OneOf("a", "b"),
+		"OneOfCaseInsensitive":               stringvalidator.OneOfCaseInsensitive("a", "b"),
+		"OneOfEnum":                          stringvalidator.OneOfEnum("a", "b"),
+		"OneOfFunc":                          stringvalidator.OneOfFunc(func(context.Context) ([]string, error) { return []string{"a"}, nil }, stringvalidator.OneOfFuncOptions{}),
+		"NoneOf":                             stringvalidator.NoneOf("a", "b"),
+		"NoneOfCaseInsensitive":              stringvalidator.NoneOfCaseInsensitive("a", "b"),
+		"RegexMatches":                       stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+		"DelimitedSegments":                  stringvalidator.DelimitedSegments("/", stringvalidator.LengthAtLeast(1)),
+		"DelimitedSegmentsBetween":           stringvalidator.DelimitedSegmentsBetween("/", 1, 3, stringvalidator.LengthAtLeast(1)),
+		"DeprecatedValues":                   stringvalidator.DeprecatedValues(map[string]string{"a": "use b instead"}),
+		"IsARN":                              stringvalidator.IsARN(stringvalidator.ARNOptions{}),
+		"IsAuthorizedKeysLine":               stringvalidator.IsAuthorizedKeysLine(),
+		"IsAuthorizedKeysLineWithMinRSABits": stringvalidator.IsAuthorizedKeysLineWithMinRSABits(3072),
+		"IsCertificateChain":                 stringvalidator.IsCertificateChain(),
+		"IsCronExpression":                   stringvalidator.IsCronExpression(),
+		"IsCronExpressionWithSeconds":        stringvalidator.IsCronExpressionWithSeconds(),
+		"IsDecimal":                          stringvalidator.IsDecimal(-1, 1),
+		"IsGlobPattern":                      stringvalidator.IsGlobPattern(),
+		"IsInteger":                          stringvalidator.IsInteger(-1, 1),
+		"IsPEM":                              stringvalidator.IsPEM(),
+		"IsPrivateKey":                       stringvalidator.IsPrivateKey(),
+		"IsQuantity":                         stringvalidator.IsQuantity(stringvalidator.QuantityUnitSystemKubernetes, "", ""),
+		"IsRegex":                            stringvalidator.IsRegex(stringvalidator.RegexOptions{}),
+		"IsSSHPublicKey":                     stringvalidator.IsSSHPublicKey(),
+		"IsSSHPublicKeyWithMinRSABits":       stringvalidator.IsSSHPublicKeyWithMinRSABits(3072),
+		"IsTimeZone":                         stringvalidator.IsTimeZone(),
+		"IsWeeklyWindow":                     stringvalidator.IsWeeklyWindow(time.Hour, 24*time.Hour),
+		"IsX509Certificate":                  stringvalidator.IsX509Certificate(stringvalidator.X509CertificateOptions{}),
+		"JSONMatchesSchema":                  stringvalidator.JSONMatchesSchema(`{"type": "object"}`),
+		"NoSecrets":                          stringvalidator.NoSecrets(),
+		"PasswordPolicy":                     stringvalidator.PasswordPolicy(stringvalidator.PasswordPolicyOptions{MinLength: 8, RequireDigit: true}),
+		"All":                                stringvalidator.All(stringvalidator.LengthAtLeast(1), stringvalidator.LengthAtMost(10)),
+		"Any":                                stringvalidator.Any(stringvalidator.OneOf("a"), stringvalidator.LengthAtLeast(5)),
+		"AnyWithAllWarnings":                 stringvalidator.AnyWithAllWarnings(stringvalidator.OneOf("a"), stringvalidator.LengthAtLeast(5)),
+		"AsWarning":                          stringvalidator.AsWarning(stringvalidator.LengthAtLeast(1)),
+		"AnyBestMatch":                       stringvalidator.AnyBestMatch(stringvalidator.OneOf("a"), stringvalidator.LengthAtLeast(5)),
+		"Sequence":                           stringvalidator.Sequence(stringvalidator.LengthAtLeast(1)),
+		"Sensitive":                          stringvalidator.Sensitive(stringvalidator.LengthAtLeast(1)),
+		"WithMessage":                        stringvalidator.WithMessage(stringvalidator.LengthAtLeast(1), "Summary", "{{.Detail}}"),
+	}
+
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validatortest.RunConformance(t, v)
+		})
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator any
+		expected  validatorspec.Constraint
+	}{
+		"All": {
+			validator: stringvalidator.All(stringvalidator.LengthAtLeast(1), stringvalidator.LengthAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAll,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"Any": {
+			validator: stringvalidator.Any(stringvalidator.LengthAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"AnyBestMatch": {
+			validator: stringvalidator.AnyBestMatch(stringvalidator.LengthAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAny,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"AnyWithAllWarnings": {
+			validator: stringvalidator.AnyWithAllWarnings(stringvalidator.LengthAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAnyWithAllWarnings,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"AsWarning": {
+			validator: stringvalidator.AsWarning(stringvalidator.LengthAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindAsWarning,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"Sensitive": {
+			validator: stringvalidator.Sensitive(stringvalidator.LengthAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindLength,
+				Min:  new(big.Float).SetInt64(1),
+			},
+		},
+		"Sequence": {
+			validator: stringvalidator.Sequence(stringvalidator.LengthAtLeast(1), stringvalidator.LengthAtLeast(1)),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindSequence,
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+					{
+						Kind: validatorspec.KindLength,
+						Min:  new(big.Float).SetInt64(1),
+					},
+				},
+			},
+		},
+		"WithMessage": {
+			validator: stringvalidator.WithMessage(stringvalidator.LengthAtLeast(1), "Summary", "{{.Detail}}"),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindLength,
+				Min:  new(big.Float).SetInt64(1),
+			},
+		},
+		"LengthAtMost": {
+			validator: stringvalidator.LengthAtMost(2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindLength,
+				Max:  new(big.Float).SetInt64(2),
+			},
+		},
+		"LengthBetween": {
+			validator: stringvalidator.LengthBetween(1, 2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindLength,
+				Min:  new(big.Float).SetInt64(1),
+				Max:  new(big.Float).SetInt64(2),
+			},
+		},
+		"UTF8LengthAtLeast": {
+			validator: stringvalidator.UTF8LengthAtLeast(1),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindUTF8Length,
+				Min:  new(big.Float).SetInt64(1),
+			},
+		},
+		"UTF8LengthAtMost": {
+			validator: stringvalidator.UTF8LengthAtMost(2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindUTF8Length,
+				Max:  new(big.Float).SetInt64(2),
+			},
+		},
+		"UTF8LengthBetween": {
+			validator: stringvalidator.UTF8LengthBetween(1, 2),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindUTF8Length,
+				Min:  new(big.Float).SetInt64(1),
+				Max:  new(big.Float).SetInt64(2),
+			},
+		},
+		"OneOf": {
+			validator: stringvalidator.OneOf("a", "b"),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindOneOf,
+				Values: validatorspec.StringValues("a", "b"),
+			},
+		},
+		"OneOfCaseInsensitive": {
+			validator: stringvalidator.OneOfCaseInsensitive("a"),
+			expected: validatorspec.Constraint{
+				Kind:            validatorspec.KindOneOf,
+				Values:          validatorspec.StringValues("a"),
+				CaseInsensitive: true,
+			},
+		},
+		"OneOfEnum": {
+			validator: stringvalidator.OneOfEnum("a"),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindOneOf,
+				Values: validatorspec.StringValues("a"),
+			},
+		},
+		"OneOfFunc": {
+			validator: stringvalidator.OneOfFunc(func(context.Context) ([]string, error) { return nil, nil }, stringvalidator.OneOfFuncOptions{}),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindOneOfFunc,
+			},
+		},
+		"NoneOf": {
+			validator: stringvalidator.NoneOf("a"),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindNoneOf,
+				Values: validatorspec.StringValues("a"),
+			},
+		},
+		"NoneOfCaseInsensitive": {
+			validator: stringvalidator.NoneOfCaseInsensitive("a"),
+			expected: validatorspec.Constraint{
+				Kind:            validatorspec.KindNoneOf,
+				Values:          validatorspec.StringValues("a"),
+				CaseInsensitive: true,
+			},
+		},
+		"DeprecatedValues": {
+			validator: stringvalidator.DeprecatedValues(map[string]string{"b": "use c", "a": "use c"}),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindDeprecatedValues,
+				Values: validatorspec.StringValues("a", "b"),
+			},
+		},
+		"RegexMatches": {
+			validator: stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+			expected: validatorspec.Constraint{
+				Kind:    validatorspec.KindRegexMatches,
+				Pattern: `^[a-z]+$`,
+			},
+		},
+		"DelimitedSegments": {
+			validator: stringvalidator.DelimitedSegmentsBetween("/", 1, 2, stringvalidator.LengthAtLeast(1), nil),
+			expected: validatorspec.Constraint{
+				Kind:      validatorspec.KindDelimitedSegments,
+				Separator: "/",
+				Min:       new(big.Float).SetInt64(1),
+				Max:       new(big.Float).SetInt64(2),
+				Children: []validatorspec.Constraint{
+					{
+						Kind: validatorspec.KindAll,
+						Children: []validatorspec.Constraint{
+							{
+								Kind: validatorspec.KindLength,
+								Min:  new(big.Float).SetInt64(1),
+							},
+						},
+					},
+					{
+						Kind: validatorspec.KindAll,
+					},
+				},
+			},
+		},
+		"IsARN": {
+			validator: stringvalidator.IsARN(stringvalidator.ARNOptions{}),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatARN,
+			},
+		},
+		"IsAuthorizedKeysLine": {
+			validator: stringvalidator.IsAuthorizedKeysLine("ssh-ed25519"),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatAuthorizedKeysLine,
+				Values: validatorspec.StringValues("ssh-ed25519"),
+			},
+		},
+		"IsCertificateChain": {
+			validator: stringvalidator.IsCertificateChain(),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatCertificateChain,
+			},
+		},
+		"IsCronExpression": {
+			validator: stringvalidator.IsCronExpression(),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatCronExpression,
+			},
+		},
+		"IsCronExpressionWithSeconds": {
+			validator: stringvalidator.IsCronExpressionWithSeconds(),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatCronExpressionWithSeconds,
+			},
+		},
+		"IsDecimal": {
+			validator: stringvalidator.IsDecimal(-1.5, 1.5),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatDecimal,
+				Min:    big.NewFloat(-1.5),
+				Max:    big.NewFloat(1.5),
+			},
+		},
+		"IsGlobPattern": {
+			validator: stringvalidator.IsGlobPattern(),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatGlobPattern,
+			},
+		},
+		"IsInteger": {
+			validator: stringvalidator.IsInteger(-1, 1),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatInteger,
+				Min:    new(big.Float).SetInt64(-1),
+				Max:    new(big.Float).SetInt64(1),
+			},
+		},
+		"IsPEM": {
+			validator: stringvalidator.IsPEM("CERTIFICATE"),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatPEM,
+				Values: validatorspec.StringValues("CERTIFICATE"),
+			},
+		},
+		"IsPrivateKey": {
+			validator: stringvalidator.IsPrivateKey(),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatPrivateKey,
+			},
+		},
+		"IsQuantity": {
+			validator: stringvalidator.IsQuantity(stringvalidator.QuantityUnitSystemKubernetes, "", ""),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatQuantity,
+			},
+		},
+		"IsQuantity-bounds": {
+			validator: stringvalidator.IsQuantity(stringvalidator.QuantityUnitSystemBytes, "1KiB", "2kB"),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatQuantity,
+				Min:    new(big.Float).SetInt64(1024),
+				Max:    new(big.Float).SetInt64(2000),
+			},
+		},
+		"IsQuantity-fractional-bounds": {
+			validator: stringvalidator.IsQuantity(stringvalidator.QuantityUnitSystemKubernetes, "-500m", "1.5"),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatQuantity,
+				Min:    new(big.Float).SetFloat64(-0.5),
+				Max:    new(big.Float).SetFloat64(1.5),
+			},
+		},
+		"IsRegex": {
+			validator: stringvalidator.IsRegex(stringvalidator.RegexOptions{}),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatRegex,
+			},
+		},
+		"IsSSHPublicKey": {
+			validator: stringvalidator.IsSSHPublicKey(),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatSSHPublicKey,
+			},
+		},
+		"IsTimeZone": {
+			validator: stringvalidator.IsTimeZone(),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatTimeZone,
+			},
+		},
+		"IsWeeklyWindow": {
+			validator: stringvalidator.IsWeeklyWindow(time.Hour, 24*time.Hour),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatWeeklyWindow,
+			},
+		},
+		"IsX509Certificate": {
+			validator: stringvalidator.IsX509Certificate(stringvalidator.X509CertificateOptions{}),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindFormat,
+				Format: validatorspec.FormatX509Certificate,
+			},
+		},
+		"JSONMatchesSchema": {
+			validator: stringvalidator.JSONMatchesSchema(`{"type": "object"}`),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindJSONSchema,
+				Schema: `{"type": "object"}`,
+			},
+		},
+		"NoSecrets": {
+			validator: stringvalidator.NoSecrets(),
+			expected: validatorspec.Constraint{
+				Kind: validatorspec.KindNoSecrets,
+			},
+		},
+		"PasswordPolicy": {
+			validator: stringvalidator.PasswordPolicy(stringvalidator.PasswordPolicyOptions{MinLength: 12, ForbiddenSubstrings: []string{"password"}, NotContaining: path.Expressions{path.MatchRoot("username")}}),
+			expected: validatorspec.Constraint{
+				Kind:   validatorspec.KindPasswordPolicy,
+				Min:    new(big.Float).SetInt64(12),
+				Values: validatorspec.StringValues("password"),
+				Paths:  path.Expressions{path.MatchRoot("username")},
+			},
+		},
+		"ConflictsWith": {
+			validator: stringvalidator.ConflictsWith(path.MatchRoot("a")),
+			expected: validatorspec.Constraint{
+				Kind:  validatorspec.KindConflictsWith,
+				Paths: path.Expressions{path.MatchRoot("a")},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatorspec.Of(context.Background(), testCase.validator)
+
+			if diff := cmp.Diff(testCase.expected, got, testvalidator.ConstraintCmpOptions, cmpopts.IgnoreFields(validatorspec.Constraint{}, "Description", "MarkdownDescription")); diff != "" {
+				t.Errorf("unexpected constraint difference: %s", diff)
+			}
+		})
+	}
+}
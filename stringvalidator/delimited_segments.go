@@ -0,0 +1,324 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+var _ validator.String = delimitedSegmentsValidator{}
+var _ function.StringParameterValidator = delimitedSegmentsValidator{}
+var _ validatorspec.ValidatorWithConstraint = delimitedSegmentsValidator{}
+
+// delimitedSegment is a single positional segment of a delimited value.
+type delimitedSegment struct {
+	// name is an optional human-readable name, such as "region", used in
+	// diagnostics to identify the segment in addition to its index.
+	name string
+
+	validators []validator.String
+}
+
+// label returns the segment identification used in descriptions and
+// diagnostics.
+func (s delimitedSegment) label(index int) string {
+	if s.name == "" {
+		return fmt.Sprintf("segment %d", index)
+	}
+
+	return fmt.Sprintf("segment %d (%s)", index, s.name)
+}
+
+type delimitedSegmentsValidator struct {
+	separator                string
+	minSegments, maxSegments int
+	segments                 []delimitedSegment
+
+	// remainder, when true, causes the final segment to contain the rest of
+	// the value, including any further separators. This is used for formats
+	// such as ARNs where the trailing resource segment may itself contain
+	// the separator.
+	remainder bool
+
+	// description, when set, replaces the generated description.
+	description string
+
+	// format, when set, is reported as the constraint instead of the
+	// segments, for formats such as ARNs.
+	format validatorspec.Format
+}
+
+func (v delimitedSegmentsValidator) invalidUsageMessage() string {
+	return fmt.Sprintf("separator cannot be empty and minSegments cannot be less than one or greater than maxSegments - separator: %q, minSegments: %d, maxSegments: %d", v.separator, v.minSegments, v.maxSegments)
+}
+
+func (v delimitedSegmentsValidator) invalidUsage() bool {
+	return v.separator == "" || v.minSegments < 1 || v.minSegments > v.maxSegments
+}
+
+// parameterUsageMessage returns an error message if any segment validator
+// does not implement function.StringParameterValidator.
+func (v delimitedSegmentsValidator) parameterUsageMessage() string {
+	for index, segment := range v.segments {
+		for _, segmentValidator := range segment.validators {
+			if _, ok := segmentValidator.(function.StringParameterValidator); !ok {
+				return fmt.Sprintf("the validator of segment %d does not implement function.StringParameterValidator", index)
+			}
+		}
+	}
+
+	return ""
+}
+
+func (v delimitedSegmentsValidator) Description(_ context.Context) string {
+	if v.description != "" {
+		return v.description
+	}
+
+	if v.minSegments == v.maxSegments {
+		return fmt.Sprintf("value must contain %d segments separated by %q", v.minSegments, v.separator)
+	}
+
+	return fmt.Sprintf("value must contain between %d and %d segments separated by %q", v.minSegments, v.maxSegments, v.separator)
+}
+
+func (v delimitedSegmentsValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v delimitedSegmentsValidator) Constraint(ctx context.Context) validatorspec.Constraint {
+	if v.format != "" {
+		return validatorspec.Constraint{
+			Kind:   validatorspec.KindFormat,
+			Format: v.format,
+		}
+	}
+
+	segments := make([]validatorspec.Constraint, 0, len(v.segments))
+
+	for _, segment := range v.segments {
+		segments = append(segments, validatorspec.Constraint{
+			Kind:     validatorspec.KindAll,
+			Children: validatorspec.OfAll(ctx, segment.validators),
+		})
+	}
+
+	return validatorspec.Constraint{
+		Kind:      validatorspec.KindDelimitedSegments,
+		Separator: v.separator,
+		Min:       new(big.Float).SetInt64(int64(v.minSegments)),
+		Max:       new(big.Float).SetInt64(int64(v.maxSegments)),
+		Children:  segments,
+	}
+}
+
+// split returns the segments of the given value.
+func (v delimitedSegmentsValidator) split(value string) []string {
+	if v.remainder {
+		return strings.SplitN(value, v.separator, v.maxSegments)
+	}
+
+	return strings.Split(value, v.separator)
+}
+
+// validateSegmentFunc validates a segment with a segment validator,
+// returning any non-error diagnostics and whether the segment is invalid.
+type validateSegmentFunc func(segmentValidator validator.String, part string) (diag.Diagnostics, bool)
+
+// validate returns every failing segment of the value, along with any
+// non-error diagnostics raised by the segment validators.
+func (v delimitedSegmentsValidator) validate(ctx context.Context, value string, validateSegment validateSegmentFunc) ([]validationFailure, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parts := v.split(value)
+
+	if l := len(parts); l < v.minSegments || l > v.maxSegments {
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("%d segments", l),
+			},
+		}, nil
+	}
+
+	var failures []validationFailure
+
+	for index, part := range parts {
+		if index >= len(v.segments) {
+			break
+		}
+
+		segment := v.segments[index]
+
+		for _, segmentValidator := range segment.validators {
+			segmentDiags, invalid := validateSegment(segmentValidator, part)
+
+			diags.Append(segmentDiags...)
+
+			if invalid {
+				failures = append(failures, validationFailure{
+					description: fmt.Sprintf("%s %s", segment.label(index), segmentValidator.Description(ctx)),
+					value:       fmt.Sprintf("%q", part),
+				})
+			}
+		}
+	}
+
+	return failures, diags
+}
+
+func (v delimitedSegmentsValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.invalidUsage() {
+		response.Diagnostics.Append(
+			validatordiag.InvalidValidatorUsageDiagnostic(
+				request.Path,
+				"DelimitedSegments",
+				v.invalidUsageMessage(),
+			),
+		)
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures, diags := v.validate(ctx, request.ConfigValue.ValueString(), func(segmentValidator validator.String, part string) (diag.Diagnostics, bool) {
+		segmentReq := validator.StringRequest{
+			Path:           request.Path,
+			PathExpression: request.PathExpression,
+			ConfigValue:    types.StringValue(part),
+			Config:         request.Config,
+		}
+		segmentResp := &validator.StringResponse{}
+
+		segmentValidator.ValidateString(ctx, segmentReq, segmentResp)
+
+		var diags diag.Diagnostics
+
+		for _, d := range segmentResp.Diagnostics {
+			if d.Severity() != diag.SeverityError {
+				diags.Append(d)
+			}
+		}
+
+		return diags, segmentResp.Diagnostics.HasError()
+	})
+
+	response.Diagnostics.Append(diags...)
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v delimitedSegmentsValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.invalidUsage() {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			request.ArgumentPosition,
+			"DelimitedSegments",
+			v.invalidUsageMessage(),
+		)
+
+		return
+	}
+
+	if msg := v.parameterUsageMessage(); msg != "" {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			request.ArgumentPosition,
+			"DelimitedSegments",
+			msg,
+		)
+
+		return
+	}
+
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures, _ := v.validate(ctx, request.Value.ValueString(), func(segmentValidator validator.String, part string) (diag.Diagnostics, bool) {
+		segmentReq := function.StringParameterValidatorRequest{
+			ArgumentPosition: request.ArgumentPosition,
+			Value:            types.StringValue(part),
+		}
+		segmentResp := &function.StringParameterValidatorResponse{}
+
+		// Segment validators were checked by parameterUsageMessage.
+		if parameterValidator, ok := segmentValidator.(function.StringParameterValidator); ok {
+			parameterValidator.ValidateParameterString(ctx, segmentReq, segmentResp)
+		}
+
+		return nil, segmentResp.Error != nil
+	})
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// DelimitedSegments returns a validator which ensures that any configured
+// attribute or function parameter value, when split by the given separator,
+// contains exactly one segment per given segment validator and that each
+// segment passes the validator at the same position. A nil segment validator
+// skips validation of that segment. Null (unconfigured) and unknown (known
+// after apply) values are skipped.
+//
+// Diagnostics identify each failing segment by its zero-based index. Warnings
+// raised by segment validators are returned as-is.
+//
+// When used with function parameters, each of the given segment validators
+// must also implement function.StringParameterValidator, which is called
+// with the segment value. Segment validators which depend on the
+// configuration, such as ConflictsWith, cannot be used with function
+// parameters.
+//
+// Use DelimitedSegmentsBetween to allow a variable number of segments.
+func DelimitedSegments(separator string, segmentValidators ...validator.String) delimitedSegmentsValidator {
+	return DelimitedSegmentsBetween(separator, len(segmentValidators), len(segmentValidators), segmentValidators...)
+}
+
+// DelimitedSegmentsBetween returns a validator which ensures that any
+// configured attribute or function parameter value, when split by the given
+// separator, contains at least minSegments and at most maxSegments segments.
+// Each segment is validated against the segment validator at the same
+// position. Segments without a corresponding validator are not further
+// validated. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+//
+// minSegments cannot be less than one or greater than maxSegments and
+// separator cannot be empty. Invalid combinations will result in an
+// implementation error message during validation.
+func DelimitedSegmentsBetween(separator string, minSegments, maxSegments int, segmentValidators ...validator.String) delimitedSegmentsValidator {
+	segments := make([]delimitedSegment, 0, len(segmentValidators))
+
+	for _, segmentValidator := range segmentValidators {
+		segment := delimitedSegment{}
+
+		if segmentValidator != nil {
+			segment.validators = []validator.String{segmentValidator}
+		}
+
+		segments = append(segments, segment)
+	}
+
+	return delimitedSegmentsValidator{
+		separator:   separator,
+		minSegments: minSegments,
+		maxSegments: maxSegments,
+		segments:    segments,
+	}
+}
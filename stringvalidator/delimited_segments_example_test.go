@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleDelimitedSegments() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Validate string value must be in the format projects/{project}/zones/{zone}.
+					stringvalidator.DelimitedSegments("/",
+						stringvalidator.OneOf("projects"),
+						stringvalidator.LengthBetween(6, 30),
+						stringvalidator.OneOf("zones"),
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+		},
+	}
+}
+
+func ExampleDelimitedSegments_function() {
+	_ = function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "example_param",
+				Validators: []function.StringParameterValidator{
+					// Validate string value must be in the format projects/{project}/zones/{zone}.
+					stringvalidator.DelimitedSegments("/",
+						stringvalidator.OneOf("projects"),
+						stringvalidator.LengthBetween(6, 30),
+						stringvalidator.OneOf("zones"),
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+		},
+	}
+}
+
+func ExampleDelimitedSegmentsBetween() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Validate string value must contain two or three dot separated
+					// segments, where the first segment is "v1" or "v2".
+					stringvalidator.DelimitedSegmentsBetween(".", 2, 3,
+						stringvalidator.OneOf("v1", "v2"),
+					),
+				},
+			},
+		},
+	}
+}
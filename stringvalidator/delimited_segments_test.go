@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestDelimitedSegmentsValidator(t *testing.T) {
+	t.Parallel()
+
+	type stringValidator interface {
+		validator.String
+		function.StringParameterValidator
+	}
+
+	type testCase struct {
+		val         types.String
+		validator   stringValidator
+		expectError bool
+	}
+
+	exact := stringvalidator.DelimitedSegments("/",
+		stringvalidator.OneOf("projects"),
+		stringvalidator.LengthAtLeast(1),
+		nil,
+	)
+
+	between := stringvalidator.DelimitedSegmentsBetween(".", 2, 3,
+		stringvalidator.LengthBetween(1, 3),
+	)
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:       types.StringUnknown(),
+			validator: exact,
+		},
+		"null String": {
+			val:       types.StringNull(),
+			validator: exact,
+		},
+		"valid String": {
+			val:       types.StringValue("projects/example/anything"),
+			validator: exact,
+		},
+		"too few segments": {
+			val:         types.StringValue("projects/example"),
+			validator:   exact,
+			expectError: true,
+		},
+		"too many segments": {
+			val:         types.StringValue("projects/example/anything/else"),
+			validator:   exact,
+			expectError: true,
+		},
+		"invalid segment": {
+			val:         types.StringValue("folders/example/anything"),
+			validator:   exact,
+			expectError: true,
+		},
+		"between valid minimum": {
+			val:       types.StringValue("abc.d"),
+			validator: between,
+		},
+		"between valid maximum": {
+			val:       types.StringValue("abc.d.e"),
+			validator: between,
+		},
+		"between invalid segment": {
+			val:         types.StringValue("abcd.e"),
+			validator:   between,
+			expectError: true,
+		},
+		"between too many segments": {
+			val:         types.StringValue("a.b.c.d"),
+			validator:   between,
+			expectError: true,
+		},
+		"invalid usage": {
+			val:         types.StringValue("a.b"),
+			validator:   stringvalidator.DelimitedSegmentsBetween(".", 3, 2),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			test.validator.ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			test.validator.ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
+
+func TestDelimitedSegmentsValidator_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val       types.String
+		validator validator.String
+		expected  diag.Diagnostics
+	}
+
+	tests := map[string]testCase{
+		"segment count": {
+			val:       types.StringValue("a/b"),
+			validator: stringvalidator.DelimitedSegments("/", nil, nil, nil),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must contain 3 segments separated by "/", got: 2 segments`,
+				),
+			},
+		},
+		"failing segments": {
+			val: types.StringValue("a/b/c"),
+			validator: stringvalidator.DelimitedSegments("/",
+				stringvalidator.OneOf("x"),
+				nil,
+				stringvalidator.LengthAtLeast(2),
+			),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test segment 0 value must be one of: ["x"], got: "a"`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test segment 2 string length must be at least 2, got: "c"`,
+				),
+			},
+		},
+		"segment warnings": {
+			val: types.StringValue("a/b"),
+			validator: stringvalidator.DelimitedSegments("/",
+				testvalidator.WarningString("warning summary", "warning details"),
+				nil,
+			),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning details"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			test.validator.ValidateString(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDelimitedSegmentsValidator_ValidateParameterString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val       types.String
+		validator function.StringParameterValidator
+		expected  *function.FuncError
+	}
+
+	tests := map[string]testCase{
+		"failing segment": {
+			val: types.StringValue("a/b"),
+			validator: stringvalidator.DelimitedSegments("/",
+				stringvalidator.OneOf("x"),
+				nil,
+			),
+			expected: function.NewArgumentFuncError(0, `Invalid Parameter Value: segment 0 value must be one of: ["x"], got: "a"`),
+		},
+		"config segment validator": {
+			val: types.StringValue("a/b"),
+			validator: stringvalidator.DelimitedSegments("/",
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ConflictsWith(path.MatchRoot("other")),
+			),
+			expected: function.NewArgumentFuncError(
+				0,
+				"Invalid Validator Usage: When validating the function definition, an implementation issue was found. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"An invalid usage of the \"DelimitedSegments\" validator was found: "+
+					"the validator of segment 1 does not implement function.StringParameterValidator",
+			),
+		},
+		"config segment validator null": {
+			val: types.StringNull(),
+			validator: stringvalidator.DelimitedSegments("/",
+				stringvalidator.ConflictsWith(path.MatchRoot("other")),
+			),
+			expected: function.NewArgumentFuncError(
+				0,
+				"Invalid Validator Usage: When validating the function definition, an implementation issue was found. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"An invalid usage of the \"DelimitedSegments\" validator was found: "+
+					"the validator of segment 0 does not implement function.StringParameterValidator",
+			),
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			test.validator.ValidateParameterString(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Error, test.expected); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
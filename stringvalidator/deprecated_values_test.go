@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestDeprecatedValuesValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		in        types.String
+		validator validator.String
+		expected  diag.Diagnostics
+	}
+
+	values := map[string]string{
+		"REDUCED": "use STANDARD instead",
+	}
+
+	testCases := map[string]testCase{
+		"not-deprecated": {
+			in:        types.StringValue("STANDARD"),
+			validator: stringvalidator.DeprecatedValues(values),
+		},
+		"deprecated": {
+			in:        types.StringValue("REDUCED"),
+			validator: stringvalidator.DeprecatedValues(values),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Attribute Value",
+					`Attribute test value "REDUCED" is deprecated: use STANDARD instead`,
+				),
+			},
+		},
+		"skip-validation-on-null": {
+			in:        types.StringNull(),
+			validator: stringvalidator.DeprecatedValues(values),
+		},
+		"skip-validation-on-unknown": {
+			in:        types.StringUnknown(),
+			validator: stringvalidator.DeprecatedValues(values),
+		},
+		"until-date-not-passed": {
+			in: types.StringValue("REDUCED"),
+			validator: stringvalidator.DeprecatedValuesUntil(values, stringvalidator.DeprecationDeadline{
+				Date: time.Date(2999, 1, 1, 0, 0, 0, 0, time.UTC),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Attribute Value",
+					`Attribute test value "REDUCED" is deprecated: use STANDARD instead`,
+				),
+			},
+		},
+		"until-date-passed": {
+			in: types.StringValue("REDUCED"),
+			validator: stringvalidator.DeprecatedValuesUntil(values, stringvalidator.DeprecationDeadline{
+				Date: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Unsupported Attribute Value",
+					`Attribute test value "REDUCED" is no longer supported: use STANDARD instead`,
+				),
+			},
+		},
+		"until-version-not-passed": {
+			in: types.StringValue("REDUCED"),
+			validator: stringvalidator.DeprecatedValuesUntil(values, stringvalidator.DeprecationDeadline{
+				Version:         "6.0.0",
+				ProviderVersion: "5.4.0",
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Attribute Value",
+					`Attribute test value "REDUCED" is deprecated: use STANDARD instead`,
+				),
+			},
+		},
+		"until-version-passed": {
+			in: types.StringValue("REDUCED"),
+			validator: stringvalidator.DeprecatedValuesUntil(values, stringvalidator.DeprecationDeadline{
+				Version:         "6.0.0",
+				ProviderVersion: "6.0.0",
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Unsupported Attribute Value",
+					`Attribute test value "REDUCED" is no longer supported: use STANDARD instead`,
+				),
+			},
+		},
+		"until-not-deprecated": {
+			in: types.StringValue("STANDARD"),
+			validator: stringvalidator.DeprecatedValuesUntil(values, stringvalidator.DeprecationDeadline{
+				Date: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			}),
+		},
+		"until-invalid-deadline": {
+			in:        types.StringValue("STANDARD"),
+			validator: stringvalidator.DeprecatedValuesUntil(values, stringvalidator.DeprecationDeadline{}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Validator Usage",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						`An invalid usage of the "DeprecatedValuesUntil" validator was found: deadline requires at least one of Date or Version`,
+				),
+			},
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: test.in,
+			}
+			res := validator.StringResponse{}
+			test.validator.ValidateString(context.TODO(), req, &res)
+
+			if diff := cmp.Diff(res.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeprecatedValuesValidator_Description(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator        validator.String
+		expected         string
+		expectedMarkdown string
+	}
+
+	values := map[string]string{
+		"REDUCED": "use STANDARD instead",
+		"LEGACY":  "use STANDARD instead",
+	}
+
+	testCases := map[string]testCase{
+		"deprecated-values": {
+			validator:        stringvalidator.DeprecatedValues(values),
+			expected:         `value should not be one of the deprecated values: ["LEGACY" "REDUCED"]`,
+			expectedMarkdown: "value should not be one of the deprecated values: [`\"LEGACY\"` `\"REDUCED\"`]",
+		},
+		"deprecated-values-until": {
+			validator: stringvalidator.DeprecatedValuesUntil(values, stringvalidator.DeprecationDeadline{
+				Date:    time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+				Version: "6.0.0",
+			}),
+			expected:         `value should not be one of the deprecated values: ["LEGACY" "REDUCED"], which are unsupported from 2027-01-01 or provider version 6.0.0`,
+			expectedMarkdown: "value should not be one of the deprecated values: [`\"LEGACY\"` `\"REDUCED\"`], which are unsupported from 2027-01-01 or provider version 6.0.0",
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.validator.Description(context.Background())
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			gotMarkdown := test.validator.MarkdownDescription(context.Background())
+
+			if diff := cmp.Diff(gotMarkdown, test.expectedMarkdown); diff != "" {
+				t.Errorf("unexpected Markdown difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleDeprecatedValuesUntil() {
+	// Typically set at build time by the provider
+	providerVersion := "5.4.0"
+
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Warn when the deprecated value REDUCED is configured, then
+					// return an error from provider version 6.0.0
+					stringvalidator.DeprecatedValuesUntil(
+						map[string]string{
+							"REDUCED": "use STANDARD instead",
+						},
+						stringvalidator.DeprecationDeadline{
+							Version:         "6.0.0",
+							ProviderVersion: providerVersion,
+						},
+					),
+				},
+			},
+		},
+	}
+}
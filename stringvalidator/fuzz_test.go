@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func FuzzLengthBetween(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.LengthBetween(1, 10))
+}
+
+func FuzzUTF8LengthBetween(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.UTF8LengthBetween(1, 10))
+}
+
+func FuzzOneOf(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.OneOf("alpha", "beta"))
+}
+
+func FuzzOneOfCaseInsensitive(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.OneOfCaseInsensitive("alpha", "beta"))
+}
+
+func FuzzNoneOf(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.NoneOf("alpha", "beta"))
+}
+
+func FuzzRegexMatches(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]+$`), ""))
+}
+
+func FuzzDelimitedSegments(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.DelimitedSegmentsBetween("/", 1, 3, stringvalidator.LengthAtLeast(1)))
+}
+
+func FuzzIsARN(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsARN(stringvalidator.ARNOptions{}))
+}
+
+func FuzzIsAuthorizedKeysLine(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsAuthorizedKeysLine())
+}
+
+func FuzzIsCertificateChain(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsCertificateChain())
+}
+
+func FuzzIsCronExpression(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsCronExpressionWithSeconds())
+}
+
+func FuzzIsDecimal(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsDecimal(-1000, 1000))
+}
+
+func FuzzIsGlobPattern(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsGlobPattern())
+}
+
+func FuzzIsInteger(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsInteger(-1000, 1000))
+}
+
+func FuzzIsPEM(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsPEM())
+}
+
+func FuzzIsPrivateKey(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsPrivateKey())
+}
+
+func FuzzIsQuantity(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsQuantity(stringvalidator.QuantityUnitSystemKubernetes, "", ""))
+}
+
+func FuzzIsRegex(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsRegex(stringvalidator.RegexOptions{}))
+}
+
+func FuzzIsSSHPublicKey(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsSSHPublicKey())
+}
+
+func FuzzIsTimeZone(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsTimeZone())
+}
+
+func FuzzIsWeeklyWindow(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsWeeklyWindow(time.Hour, 24*time.Hour))
+}
+
+func FuzzIsX509Certificate(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.IsX509Certificate(stringvalidator.X509CertificateOptions{}))
+}
+
+func FuzzJSONMatchesSchema(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.JSONMatchesSchema(`{"type": "object", "required": ["name"]}`))
+}
+
+func FuzzNoSecrets(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.NoSecrets())
+}
+
+func FuzzPasswordPolicy(f *testing.F) {
+	validatortest.FuzzString(f, stringvalidator.PasswordPolicy(stringvalidator.PasswordPolicyOptions{MinLength: 8, RequireDigit: true, MaxRepeatedCharacters: 2}))
+}
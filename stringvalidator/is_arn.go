@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+var (
+	arnPartitionRegexp = regexp.MustCompile(`^[a-z0-9-]+$`)
+	arnServiceRegexp   = regexp.MustCompile(`^[a-z0-9-]+$`)
+	arnRegionRegexp    = regexp.MustCompile(`^[a-z0-9-]*$`)
+	arnAccountIDRegexp = regexp.MustCompile(`^([0-9]{12}|aws)?$`)
+)
+
+// ARNOptions are the optional constraints of the IsARN validator. Empty
+// fields apply no additional constraint.
+type ARNOptions struct {
+	// Partitions is the list of allowed partitions, such as "aws" or
+	// "aws-us-gov".
+	Partitions []string
+
+	// Services is the list of allowed service namespaces, such as "s3".
+	Services []string
+
+	// Regions is the list of allowed regions, such as "us-east-1". An empty
+	// region is only allowed if RequireRegion is false.
+	Regions []string
+
+	// RequireRegion ensures the region segment is not empty.
+	RequireRegion bool
+
+	// AccountIDs is the list of allowed account IDs. An empty account ID is
+	// only allowed if RequireAccountID is false.
+	AccountIDs []string
+
+	// RequireAccountID ensures the account ID segment is not empty.
+	RequireAccountID bool
+
+	// ResourceTypes is the list of allowed resource types. The resource type
+	// is the portion of the resource segment preceding the first "/" or ":".
+	ResourceTypes []string
+}
+
+// IsARN returns a validator which ensures that any configured attribute or
+// function parameter value is an Amazon Resource Name (ARN) in the format:
+//
+//	arn:partition:service:region:account-id:resource
+//
+// The resource segment may contain further ":" or "/" separators. By default
+// the partition and service must be non-empty lowercase alphanumeric values
+// with hyphens, the region may be empty or a lowercase alphanumeric value
+// with hyphens, the account ID may be empty, a 12 digit number, or "aws", and
+// the resource must be non-empty. Additional constraints can be given via
+// ARNOptions. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+//
+// Diagnostics identify each failing segment by its zero-based index and name.
+func IsARN(opts ARNOptions) delimitedSegmentsValidator {
+	partition := []validator.String{RegexMatches(arnPartitionRegexp, "value must be a lowercase alphanumeric partition")}
+	if len(opts.Partitions) > 0 {
+		partition = append(partition, OneOf(opts.Partitions...))
+	}
+
+	service := []validator.String{RegexMatches(arnServiceRegexp, "value must be a lowercase alphanumeric service namespace")}
+	if len(opts.Services) > 0 {
+		service = append(service, OneOf(opts.Services...))
+	}
+
+	region := []validator.String{RegexMatches(arnRegionRegexp, "value must be empty or a lowercase alphanumeric region")}
+	if opts.RequireRegion {
+		region = append(region, LengthAtLeast(1))
+	}
+	if len(opts.Regions) > 0 {
+		region = append(region, arnOptionalOneOf(opts.Regions))
+	}
+
+	accountID := []validator.String{RegexMatches(arnAccountIDRegexp, `value must be empty, a 12 digit account ID, or "aws"`)}
+	if opts.RequireAccountID {
+		accountID = append(accountID, LengthAtLeast(1))
+	}
+	if len(opts.AccountIDs) > 0 {
+		accountID = append(accountID, arnOptionalOneOf(opts.AccountIDs))
+	}
+
+	resource := []validator.String{LengthAtLeast(1)}
+	if len(opts.ResourceTypes) > 0 {
+		resource = append(resource, arnResourceTypeValidator{resourceTypes: opts.ResourceTypes})
+	}
+
+	return delimitedSegmentsValidator{
+		separator:   ":",
+		minSegments: 6,
+		maxSegments: 6,
+		segments: []delimitedSegment{
+			{name: "prefix", validators: []validator.String{OneOf("arn")}},
+			{name: "partition", validators: partition},
+			{name: "service", validators: service},
+			{name: "region", validators: region},
+			{name: "account ID", validators: accountID},
+			{name: "resource", validators: resource},
+		},
+		remainder:   true,
+		description: "value must be a valid ARN in the format arn:partition:service:region:account-id:resource",
+		format:      validatorspec.FormatARN,
+	}
+}
+
+// arnOptionalOneOf returns a validator which allows the empty string or one of
+// the given values. Requiring a non-empty value is handled separately so that
+// each constraint is reported on its own.
+func arnOptionalOneOf(values []string) validator.String {
+	return arnOptionalOneOfValidator{values: values}
+}
+
+var _ validator.String = arnOptionalOneOfValidator{}
+var _ function.StringParameterValidator = arnOptionalOneOfValidator{}
+
+// arnOptionalOneOfValidator validates an optional ARN segment.
+type arnOptionalOneOfValidator struct {
+	values []string
+}
+
+func (v arnOptionalOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be empty or one of: %q", v.values)
+}
+
+func (v arnOptionalOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v arnOptionalOneOfValidator) valid(value string) bool {
+	return value == "" || slices.Contains(v.values, value)
+}
+
+func (v arnOptionalOneOfValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if v.valid(value) {
+		return
+	}
+
+	response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		request.Path,
+		v.Description(ctx),
+		value,
+	))
+}
+
+func (v arnOptionalOneOfValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	value := request.Value.ValueString()
+
+	if v.valid(value) {
+		return
+	}
+
+	response.Error = validatorfuncerr.InvalidParameterValueMatchFuncError(
+		request.ArgumentPosition,
+		v.Description(ctx),
+		value,
+	)
+}
+
+var _ validator.String = arnResourceTypeValidator{}
+var _ function.StringParameterValidator = arnResourceTypeValidator{}
+
+// arnResourceTypeValidator validates the resource type portion of an ARN
+// resource segment.
+type arnResourceTypeValidator struct {
+	resourceTypes []string
+}
+
+func (v arnResourceTypeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("resource type must be one of: %q", v.resourceTypes)
+}
+
+func (v arnResourceTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// resourceType returns the resource type portion of an ARN resource segment.
+func (v arnResourceTypeValidator) resourceType(resource string) string {
+	if i := strings.IndexAny(resource, ":/"); i >= 0 {
+		return resource[:i]
+	}
+
+	return resource
+}
+
+func (v arnResourceTypeValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	resourceType := v.resourceType(request.ConfigValue.ValueString())
+
+	if slices.Contains(v.resourceTypes, resourceType) {
+		return
+	}
+
+	response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		request.Path,
+		v.Description(ctx),
+		resourceType,
+	))
+}
+
+func (v arnResourceTypeValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	resourceType := v.resourceType(request.Value.ValueString())
+
+	if slices.Contains(v.resourceTypes, resourceType) {
+		return
+	}
+
+	response.Error = validatorfuncerr.InvalidParameterValueMatchFuncError(
+		request.ArgumentPosition,
+		v.Description(ctx),
+		resourceType,
+	)
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleIsARN() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Validate string value must be an IAM role ARN.
+					stringvalidator.IsARN(stringvalidator.ARNOptions{
+						Services:         []string{"iam"},
+						RequireAccountID: true,
+						ResourceTypes:    []string{"role"},
+					}),
+				},
+			},
+		},
+	}
+}
+
+func ExampleIsARN_function() {
+	_ = function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "example_param",
+				Validators: []function.StringParameterValidator{
+					// Validate string value must be an ARN in the aws partition.
+					stringvalidator.IsARN(stringvalidator.ARNOptions{
+						Partitions: []string{"aws"},
+					}),
+				},
+			},
+		},
+	}
+}
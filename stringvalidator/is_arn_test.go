@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsARNValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		opts        stringvalidator.ARNOptions
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue("arn:aws:ec2:us-east-1:123456789012:instance/i-1234567890abcdef0"),
+		},
+		"valid resource with separators": {
+			val: types.StringValue("arn:aws:logs:us-east-1:123456789012:log-group:example:*"),
+		},
+		"valid empty region and account": {
+			val: types.StringValue("arn:aws:s3:::example-bucket"),
+		},
+		"valid aws managed account": {
+			val: types.StringValue("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+		},
+		"invalid prefix": {
+			val:         types.StringValue("urn:aws:s3:::example-bucket"),
+			expectError: true,
+		},
+		"invalid too few segments": {
+			val:         types.StringValue("arn:aws:s3:::"),
+			expectError: true,
+		},
+		"invalid missing segments": {
+			val:         types.StringValue("arn:aws:s3"),
+			expectError: true,
+		},
+		"invalid account ID": {
+			val:         types.StringValue("arn:aws:ec2:us-east-1:1234:instance/i-1"),
+			expectError: true,
+		},
+		"partition allowed": {
+			val:  types.StringValue("arn:aws-us-gov:s3:::example-bucket"),
+			opts: stringvalidator.ARNOptions{Partitions: []string{"aws", "aws-us-gov"}},
+		},
+		"partition not allowed": {
+			val:         types.StringValue("arn:aws-cn:s3:::example-bucket"),
+			opts:        stringvalidator.ARNOptions{Partitions: []string{"aws", "aws-us-gov"}},
+			expectError: true,
+		},
+		"service not allowed": {
+			val:         types.StringValue("arn:aws:s3:::example-bucket"),
+			opts:        stringvalidator.ARNOptions{Services: []string{"ec2"}},
+			expectError: true,
+		},
+		"region required": {
+			val:         types.StringValue("arn:aws:s3:::example-bucket"),
+			opts:        stringvalidator.ARNOptions{RequireRegion: true},
+			expectError: true,
+		},
+		"region not allowed": {
+			val:         types.StringValue("arn:aws:ec2:eu-west-1:123456789012:instance/i-1"),
+			opts:        stringvalidator.ARNOptions{Regions: []string{"us-east-1"}},
+			expectError: true,
+		},
+		"account ID required": {
+			val:         types.StringValue("arn:aws:s3:::example-bucket"),
+			opts:        stringvalidator.ARNOptions{RequireAccountID: true},
+			expectError: true,
+		},
+		"account ID allowed": {
+			val:  types.StringValue("arn:aws:ec2:us-east-1:123456789012:instance/i-1"),
+			opts: stringvalidator.ARNOptions{AccountIDs: []string{"123456789012"}},
+		},
+		"resource type allowed": {
+			val:  types.StringValue("arn:aws:ec2:us-east-1:123456789012:instance/i-1"),
+			opts: stringvalidator.ARNOptions{ResourceTypes: []string{"instance", "volume"}},
+		},
+		"resource type not allowed": {
+			val:         types.StringValue("arn:aws:ec2:us-east-1:123456789012:snapshot/snap-1"),
+			opts:        stringvalidator.ARNOptions{ResourceTypes: []string{"instance", "volume"}},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsARN(test.opts).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsARN(test.opts).ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
+
+func TestIsARNValidator_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	request := validator.StringRequest{
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+		ConfigValue:    types.StringValue("arn:aws:ec2:eu-west-1:123456789012:snapshot/snap-1"),
+	}
+	response := validator.StringResponse{}
+
+	stringvalidator.IsARN(stringvalidator.ARNOptions{
+		Regions:       []string{"us-east-1"},
+		ResourceTypes: []string{"instance"},
+	}).ValidateString(context.Background(), request, &response)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value",
+			`Attribute test segment 3 (region) value must be empty or one of: ["us-east-1"], got: "eu-west-1"`,
+		),
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value",
+			`Attribute test segment 5 (resource) resource type must be one of: ["instance"], got: "snapshot/snap-1"`,
+		),
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+var _ validator.String = isCertificateChainValidator{}
+var _ function.StringParameterValidator = isCertificateChainValidator{}
+var _ validatorspec.ValidatorWithConstraint = isCertificateChainValidator{}
+
+type isCertificateChainValidator struct{}
+
+func (v isCertificateChainValidator) Description(_ context.Context) string {
+	return "value must be a PEM encoded X.509 certificate chain ordered from leaf to root"
+}
+
+func (v isCertificateChainValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isCertificateChainValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatCertificateChain,
+	}
+}
+
+func (v isCertificateChainValidator) validate(ctx context.Context, value string) []validationFailure {
+	blocks, failure := decodePEMBlocks(ctx, v, value)
+
+	if failure != nil {
+		return []validationFailure{*failure}
+	}
+
+	var failures []validationFailure
+
+	certs := make([]*x509.Certificate, 0, len(blocks))
+
+	for index, block := range blocks {
+		if block.Type != "CERTIFICATE" {
+			failures = append(failures, validationFailure{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("block %d of type %q", index, block.Type),
+			})
+
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+
+		if err != nil {
+			failures = append(failures, validationFailure{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("unparseable certificate %d: %s", index, err),
+			})
+
+			continue
+		}
+
+		certs = append(certs, cert)
+	}
+
+	// Signatures can only be verified once every certificate is parsed.
+	if len(failures) > 0 {
+		return failures
+	}
+
+	for index := 0; index < len(certs)-1; index++ {
+		if err := certs[index].CheckSignatureFrom(certs[index+1]); err != nil {
+			failures = append(failures, validationFailure{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("certificate %d not signed by certificate %d: %s", index, index+1, err),
+			})
+		}
+	}
+
+	return failures
+}
+
+func (v isCertificateChainValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isCertificateChainValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsCertificateChain returns a validator which ensures that any configured
+// attribute or function parameter value is one or more PEM encoded X.509
+// certificates, where each certificate is signed by the certificate which
+// follows it. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+//
+// The chain is not verified against any system roots and the final
+// certificate is not required to be self-signed, so intermediate-only
+// chains are accepted.
+func IsCertificateChain() isCertificateChainValidator {
+	return isCertificateChainValidator{}
+}
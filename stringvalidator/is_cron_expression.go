@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// cronField describes the allowed values of a single cron expression field.
+type cronField struct {
+	name     string
+	min, max int
+
+	// names are the case-insensitive aliases for values, starting at min.
+	names []string
+
+	// allowQuestionMark enables "?" as a synonym of "*", which is commonly
+	// accepted in day fields.
+	allowQuestionMark bool
+}
+
+var (
+	cronSecondField     = cronField{name: "second", min: 0, max: 59}
+	cronMinuteField     = cronField{name: "minute", min: 0, max: 59}
+	cronHourField       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonthField = cronField{name: "day-of-month", min: 1, max: 31, allowQuestionMark: true}
+	cronMonthField      = cronField{
+		name:  "month",
+		min:   1,
+		max:   12,
+		names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"},
+	}
+	// Both 0 and 7 represent Sunday.
+	cronDayOfWeekField = cronField{
+		name:              "day-of-week",
+		min:               0,
+		max:               7,
+		names:             []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+		allowQuestionMark: true,
+	}
+)
+
+// cronMacros are the supported predefined schedules, which replace the
+// entire expression.
+var cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+var _ validator.String = isCronExpressionValidator{}
+var _ function.StringParameterValidator = isCronExpressionValidator{}
+var _ validatorspec.ValidatorWithConstraint = isCronExpressionValidator{}
+
+type isCronExpressionValidator struct {
+	fields []cronField
+}
+
+func (v isCronExpressionValidator) Description(_ context.Context) string {
+	names := make([]string, 0, len(v.fields))
+
+	for _, field := range v.fields {
+		names = append(names, field.name)
+	}
+
+	return fmt.Sprintf("value must be a cron expression with %d fields: %s", len(v.fields), strings.Join(names, " "))
+}
+
+func (v isCronExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isCronExpressionValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	format := validatorspec.FormatCronExpression
+
+	if len(v.fields) == 6 {
+		format = validatorspec.FormatCronExpressionWithSeconds
+	}
+
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: format,
+	}
+}
+
+func (v isCronExpressionValidator) validate(ctx context.Context, value string) []validationFailure {
+	if len(v.fields) == 5 && slices.Contains(cronMacros, value) {
+		return nil
+	}
+
+	parts := strings.Fields(value)
+
+	if len(parts) != len(v.fields) {
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("%d fields", len(parts)),
+			},
+		}
+	}
+
+	var failures []validationFailure
+
+	for index, part := range parts {
+		field := v.fields[index]
+
+		if reason := field.validate(part); reason != "" {
+			failures = append(failures, validationFailure{
+				description: fmt.Sprintf("cron expression %s field (index %d) %s", field.name, index, reason),
+				value:       fmt.Sprintf("%q", part),
+			})
+		}
+	}
+
+	return failures
+}
+
+// validate returns the reason the given field value is invalid, if any.
+func (f cronField) validate(value string) string {
+	for _, item := range strings.Split(value, ",") {
+		if reason := f.validateItem(item); reason != "" {
+			return reason
+		}
+	}
+
+	return ""
+}
+
+// validateItem validates a single item of a comma separated list, which is
+// a wildcard, value, or range, optionally followed by a step.
+func (f cronField) validateItem(item string) string {
+	if item == "" {
+		return "must not contain empty list items"
+	}
+
+	base, step, hasStep := strings.Cut(item, "/")
+
+	if hasStep {
+		n, err := strconv.Atoi(step)
+
+		if err != nil || n < 1 {
+			return fmt.Sprintf("step %q must be a positive integer", step)
+		}
+	}
+
+	if base == "*" || (base == "?" && f.allowQuestionMark && !hasStep) {
+		return ""
+	}
+
+	start, end, isRange := strings.Cut(base, "-")
+
+	startValue, reason := f.parseValue(start)
+
+	if reason != "" {
+		return reason
+	}
+
+	if !isRange {
+		return ""
+	}
+
+	endValue, reason := f.parseValue(end)
+
+	if reason != "" {
+		return reason
+	}
+
+	if startValue > endValue {
+		return fmt.Sprintf("range start %q must not be greater than range end %q", start, end)
+	}
+
+	return ""
+}
+
+// parseValue parses a single numeric or named value of the field.
+func (f cronField) parseValue(value string) (int, string) {
+	for index, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + index, ""
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+
+	if err != nil {
+		if len(f.names) > 0 {
+			return 0, fmt.Sprintf("value %q must be a number between %d and %d or one of: %s", value, f.min, f.max, strings.Join(f.names, ", "))
+		}
+
+		return 0, fmt.Sprintf("value %q must be a number between %d and %d", value, f.min, f.max)
+	}
+
+	if n < f.min || n > f.max {
+		return 0, fmt.Sprintf("value %d must be between %d and %d", n, f.min, f.max)
+	}
+
+	return n, ""
+}
+
+func (v isCronExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isCronExpressionValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsCronExpression returns a validator which ensures that any configured
+// attribute or function parameter value is a standard 5 field cron
+// expression:
+//
+//	minute hour day-of-month month day-of-week
+//
+// Each field may be a wildcard (*), a value, a range (1-5), a list of those
+// (1,3,5), and may include a step (*/15 or 0-30/5). Months accept the names
+// JAN through DEC and days of the week accept the names SUN through SAT,
+// case-insensitively. Day of the week values 0 and 7 are both Sunday. The day
+// fields also accept "?" as a wildcard. The predefined schedules @yearly,
+// @annually, @monthly, @weekly, @daily, @midnight, and @hourly are accepted.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+//
+// Diagnostics identify each invalid field by its name and zero-based index.
+//
+// Use IsCronExpressionWithSeconds for 6 field expressions.
+func IsCronExpression() isCronExpressionValidator {
+	return isCronExpressionValidator{
+		fields: []cronField{
+			cronMinuteField,
+			cronHourField,
+			cronDayOfMonthField,
+			cronMonthField,
+			cronDayOfWeekField,
+		},
+	}
+}
+
+// IsCronExpressionWithSeconds returns a validator which ensures that any
+// configured attribute or function parameter value is a 6 field cron
+// expression with a leading seconds field:
+//
+//	second minute hour day-of-month month day-of-week
+//
+// Field syntax is the same as IsCronExpression. Predefined schedules, such as
+// @daily, are not accepted. Null (unconfigured) and unknown (known after
+// apply) values are skipped.
+func IsCronExpressionWithSeconds() isCronExpressionValidator {
+	return isCronExpressionValidator{
+		fields: []cronField{
+			cronSecondField,
+			cronMinuteField,
+			cronHourField,
+			cronDayOfMonthField,
+			cronMonthField,
+			cronDayOfWeekField,
+		},
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsCronExpressionValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		seconds     bool
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid wildcards": {
+			val: types.StringValue("* * * * *"),
+		},
+		"valid values": {
+			val: types.StringValue("30 2 15 6 3"),
+		},
+		"valid lists ranges steps": {
+			val: types.StringValue("0,15,30,45 9-17 */2 1-12/3 1-5"),
+		},
+		"valid names": {
+			val: types.StringValue("0 12 ? jan-Mar MON,wed,FRI"),
+		},
+		"valid sunday seven": {
+			val: types.StringValue("0 0 * * 7"),
+		},
+		"valid macro": {
+			val: types.StringValue("@daily"),
+		},
+		"valid seconds": {
+			val:     types.StringValue("0 */5 * * * *"),
+			seconds: true,
+		},
+		"invalid field count": {
+			val:         types.StringValue("* * * *"),
+			expectError: true,
+		},
+		"invalid seconds field count": {
+			val:         types.StringValue("* * * * *"),
+			seconds:     true,
+			expectError: true,
+		},
+		"invalid seconds macro": {
+			val:         types.StringValue("@hourly"),
+			seconds:     true,
+			expectError: true,
+		},
+		"invalid minute range": {
+			val:         types.StringValue("60 * * * *"),
+			expectError: true,
+		},
+		"invalid hour": {
+			val:         types.StringValue("0 24 * * *"),
+			expectError: true,
+		},
+		"invalid day of month zero": {
+			val:         types.StringValue("0 0 0 * *"),
+			expectError: true,
+		},
+		"invalid month name": {
+			val:         types.StringValue("0 0 1 FOO *"),
+			expectError: true,
+		},
+		"invalid reversed range": {
+			val:         types.StringValue("0 17-9 * * *"),
+			expectError: true,
+		},
+		"invalid step zero": {
+			val:         types.StringValue("*/0 * * * *"),
+			expectError: true,
+		},
+		"invalid step value": {
+			val:         types.StringValue("*/x * * * *"),
+			expectError: true,
+		},
+		"invalid empty list item": {
+			val:         types.StringValue("1,,2 * * * *"),
+			expectError: true,
+		},
+		"invalid question mark in minute": {
+			val:         types.StringValue("? * * * *"),
+			expectError: true,
+		},
+		"invalid unknown macro": {
+			val:         types.StringValue("@reboot"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			cronValidator(test.seconds).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			cronValidator(test.seconds).ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
+
+func cronValidator(seconds bool) interface {
+	validator.String
+	function.StringParameterValidator
+} {
+	if seconds {
+		return stringvalidator.IsCronExpressionWithSeconds()
+	}
+
+	return stringvalidator.IsCronExpression()
+}
+
+func TestIsCronExpressionValidator_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	request := validator.StringRequest{
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+		ConfigValue:    types.StringValue("0 25 * FOO *"),
+	}
+	response := validator.StringResponse{}
+
+	stringvalidator.IsCronExpression().ValidateString(context.Background(), request, &response)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value",
+			`Attribute test cron expression hour field (index 1) value 25 must be between 0 and 23, got: "25"`,
+		),
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value",
+			`Attribute test cron expression month field (index 3) value "FOO" must be a number between 1 and 12 or one of: JAN, FEB, MAR, APR, MAY, JUN, JUL, AUG, SEP, OCT, NOV, DEC, got: "FOO"`,
+		),
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+var decimalRegexp = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+var _ validator.String = isDecimalValidator{}
+var _ function.StringParameterValidator = isDecimalValidator{}
+var _ validatorspec.ValidatorWithConstraint = isDecimalValidator{}
+
+type isDecimalValidator struct {
+	minValue, maxValue float64
+}
+
+func (v isDecimalValidator) invalidUsage() bool {
+	return math.IsNaN(v.minValue) || math.IsNaN(v.maxValue) || v.minValue > v.maxValue
+}
+
+func (v isDecimalValidator) invalidUsageMessage() string {
+	return fmt.Sprintf("minValue cannot be greater than maxValue and neither can be NaN - minValue: %f, maxValue: %f", v.minValue, v.maxValue)
+}
+
+func (v isDecimalValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a decimal number between %f and %f", v.minValue, v.maxValue)
+}
+
+func (v isDecimalValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isDecimalValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatDecimal,
+		Min:    big.NewFloat(v.minValue),
+		Max:    big.NewFloat(v.maxValue),
+	}
+}
+
+func (v isDecimalValidator) valid(value string) bool {
+	if !decimalRegexp.MatchString(value) {
+		return false
+	}
+
+	// Compare exactly, so values with more precision than a float64 are
+	// not rounded into range.
+	n, ok := new(big.Rat).SetString(value)
+
+	if !ok {
+		return false
+	}
+
+	if !math.IsInf(v.minValue, -1) && n.Cmp(new(big.Rat).SetFloat64(v.minValue)) < 0 {
+		return false
+	}
+
+	if !math.IsInf(v.maxValue, 1) && n.Cmp(new(big.Rat).SetFloat64(v.maxValue)) > 0 {
+		return false
+	}
+
+	return true
+}
+
+func (v isDecimalValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.invalidUsage() {
+		response.Diagnostics.Append(
+			validatordiag.InvalidValidatorUsageDiagnostic(
+				request.Path,
+				"IsDecimal",
+				v.invalidUsageMessage(),
+			),
+		)
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if !v.valid(value) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q", value),
+		))
+	}
+}
+
+func (v isDecimalValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.invalidUsage() {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			request.ArgumentPosition,
+			"IsDecimal",
+			v.invalidUsageMessage(),
+		)
+
+		return
+	}
+
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	value := request.Value.ValueString()
+
+	if !v.valid(value) {
+		response.Error = validatorfuncerr.InvalidParameterValueFuncError(
+			request.ArgumentPosition,
+			v.Description(ctx),
+			fmt.Sprintf("%q", value),
+		)
+	}
+}
+
+// IsDecimal returns a validator which ensures that any configured attribute
+// or function parameter value is a decimal number in plain notation, such as
+// "3", "-0.25", or "+1.5", greater than or equal to the given minimum and less
+// than or equal to the given maximum. Exponent notation, hexadecimal, and
+// special values such as "NaN" or "Inf" are not accepted. Use math.Inf for an
+// unbounded minimum or maximum. Null (unconfigured) and unknown (known after
+// apply) values are skipped.
+//
+// minValue cannot be greater than maxValue and neither can be NaN. Invalid
+// combinations of minValue and maxValue will result in an implementation
+// error message during validation.
+func IsDecimal(minValue, maxValue float64) isDecimalValidator {
+	return isDecimalValidator{
+		minValue: minValue,
+		maxValue: maxValue,
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsDecimalValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		minValue    float64
+		maxValue    float64
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:      types.StringUnknown(),
+			minValue: 0,
+			maxValue: 10,
+		},
+		"null String": {
+			val:      types.StringNull(),
+			minValue: 0,
+			maxValue: 10,
+		},
+		"valid integer": {
+			val:      types.StringValue("3"),
+			minValue: 0,
+			maxValue: 10,
+		},
+		"valid fraction": {
+			val:      types.StringValue("-0.25"),
+			minValue: -1,
+			maxValue: 1,
+		},
+		"valid plus sign": {
+			val:      types.StringValue("+1.5"),
+			minValue: 0,
+			maxValue: 10,
+		},
+		"valid minimum": {
+			val:      types.StringValue("0.5"),
+			minValue: 0.5,
+			maxValue: 1,
+		},
+		"valid maximum": {
+			val:      types.StringValue("1.0"),
+			minValue: 0.5,
+			maxValue: 1,
+		},
+		"valid unbounded": {
+			val:      types.StringValue("-123456789012345678901234567890.5"),
+			minValue: math.Inf(-1),
+			maxValue: math.Inf(1),
+		},
+		"invalid below minimum": {
+			val:         types.StringValue("0.49"),
+			minValue:    0.5,
+			maxValue:    1,
+			expectError: true,
+		},
+		"invalid above maximum precision": {
+			val:         types.StringValue("1.00000000000000000001"),
+			minValue:    0.5,
+			maxValue:    1,
+			expectError: true,
+		},
+		"invalid exponent": {
+			val:         types.StringValue("1e3"),
+			minValue:    math.Inf(-1),
+			maxValue:    math.Inf(1),
+			expectError: true,
+		},
+		"invalid hexadecimal": {
+			val:         types.StringValue("0x10"),
+			minValue:    math.Inf(-1),
+			maxValue:    math.Inf(1),
+			expectError: true,
+		},
+		"invalid NaN": {
+			val:         types.StringValue("NaN"),
+			minValue:    math.Inf(-1),
+			maxValue:    math.Inf(1),
+			expectError: true,
+		},
+		"invalid Inf": {
+			val:         types.StringValue("Inf"),
+			minValue:    math.Inf(-1),
+			maxValue:    math.Inf(1),
+			expectError: true,
+		},
+		"invalid trailing decimal point": {
+			val:         types.StringValue("1."),
+			minValue:    math.Inf(-1),
+			maxValue:    math.Inf(1),
+			expectError: true,
+		},
+		"invalid leading decimal point": {
+			val:         types.StringValue(".5"),
+			minValue:    math.Inf(-1),
+			maxValue:    math.Inf(1),
+			expectError: true,
+		},
+		"invalid empty": {
+			val:         types.StringValue(""),
+			minValue:    math.Inf(-1),
+			maxValue:    math.Inf(1),
+			expectError: true,
+		},
+		"invalid validator usage - minValue > maxValue": {
+			val:         types.StringValue("5"),
+			minValue:    10,
+			maxValue:    0,
+			expectError: true,
+		},
+		"invalid validator usage - NaN": {
+			val:         types.StringValue("5"),
+			minValue:    math.NaN(),
+			maxValue:    10,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsDecimal(test.minValue, test.maxValue).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsDecimal(test.minValue, test.maxValue).ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
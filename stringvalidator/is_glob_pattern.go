@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+var _ validator.String = isGlobPatternValidator{}
+var _ function.StringParameterValidator = isGlobPatternValidator{}
+var _ validatorspec.ValidatorWithConstraint = isGlobPatternValidator{}
+
+type isGlobPatternValidator struct{}
+
+func (v isGlobPatternValidator) Description(_ context.Context) string {
+	return "value must be a valid glob pattern"
+}
+
+func (v isGlobPatternValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isGlobPatternValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatGlobPattern,
+	}
+}
+
+func (v isGlobPatternValidator) validate(ctx context.Context, value string) []validationFailure {
+	// path.Match is the authority on validity, while globSyntaxError
+	// determines the position of the error for the diagnostic.
+	if _, err := path.Match(value, ""); err == nil {
+		return nil
+	}
+
+	description := v.Description(ctx)
+
+	if offset, reason := globSyntaxError(value); reason != "" {
+		description = fmt.Sprintf("%s: %s at offset %d", description, reason, offset)
+	}
+
+	return []validationFailure{
+		{
+			description: description,
+			value:       fmt.Sprintf("%q", value),
+		},
+	}
+}
+
+func (v isGlobPatternValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isGlobPatternValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsGlobPattern returns a validator which ensures that any configured
+// attribute or function parameter value is a valid glob pattern as accepted
+// by the Go path.Match function, which supports *, ?, character classes such
+// as [a-z] or [^0-9], and backslash escapes. Null (unconfigured) and unknown
+// (known after apply) values are skipped.
+//
+// Diagnostics include the offset of the invalid portion of the pattern.
+func IsGlobPattern() isGlobPatternValidator {
+	return isGlobPatternValidator{}
+}
+
+// globSyntaxError returns the offset and reason of the first syntax error in
+// the pattern, following the same grammar as path.Match.
+func globSyntaxError(pattern string) (int, string) {
+	for i := 0; i < len(pattern); {
+		switch pattern[i] {
+		case '\\':
+			if i+1 >= len(pattern) {
+				return i, "trailing backslash"
+			}
+
+			_, size := utf8.DecodeRuneInString(pattern[i+1:])
+			i += 1 + size
+		case '[':
+			end, offset, reason := globClassEnd(pattern, i)
+
+			if reason != "" {
+				return offset, reason
+			}
+
+			i = end
+		default:
+			i++
+		}
+	}
+
+	return 0, ""
+}
+
+// globClassEnd returns the offset following the character class starting at
+// the given offset or the offset and reason of a syntax error within it.
+func globClassEnd(pattern string, start int) (int, int, string) {
+	i := start + 1
+
+	if i < len(pattern) && pattern[i] == '^' {
+		i++
+	}
+
+	for ranges := 0; ; ranges++ {
+		if i < len(pattern) && pattern[i] == ']' && ranges > 0 {
+			return i + 1, 0, ""
+		}
+
+		next, offset, reason := globClassChar(pattern, start, i)
+
+		if reason != "" {
+			return 0, offset, reason
+		}
+
+		i = next
+
+		if pattern[i] == '-' {
+			next, offset, reason = globClassChar(pattern, start, i+1)
+
+			if reason != "" {
+				return 0, offset, reason
+			}
+
+			i = next
+		}
+	}
+}
+
+// globClassChar returns the offset following the possibly escaped character
+// at the given offset within a character class starting at classStart. As
+// with path.Match, the character must be followed by further pattern data.
+func globClassChar(pattern string, classStart int, i int) (int, int, string) {
+	if i >= len(pattern) {
+		return 0, classStart, "unterminated character class"
+	}
+
+	switch pattern[i] {
+	case '-', ']':
+		if pattern[i] == ']' && (i == classStart+1 || (i == classStart+2 && pattern[classStart+1] == '^')) {
+			return 0, i, "empty character class"
+		}
+
+		return 0, i, fmt.Sprintf("unexpected %q in character class", pattern[i])
+	case '\\':
+		i++
+
+		if i >= len(pattern) {
+			return 0, classStart, "unterminated character class"
+		}
+	}
+
+	r, size := utf8.DecodeRuneInString(pattern[i:])
+
+	if r == utf8.RuneError && size == 1 {
+		return 0, i, "invalid UTF-8 in character class"
+	}
+
+	i += size
+
+	if i >= len(pattern) {
+		return 0, classStart, "unterminated character class"
+	}
+
+	return i, 0, ""
+}
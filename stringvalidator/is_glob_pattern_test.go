@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsGlobPatternValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid literal": {
+			val: types.StringValue("logs/app.log"),
+		},
+		"valid wildcards": {
+			val: types.StringValue("logs/*/app-??.log"),
+		},
+		"valid class": {
+			val: types.StringValue("[a-z]*[^0-9]"),
+		},
+		"valid escape": {
+			val: types.StringValue(`\*literal\[`),
+		},
+		"valid escaped class characters": {
+			val: types.StringValue(`[\]\-]`),
+		},
+		"invalid unterminated class": {
+			val:         types.StringValue("logs/[a-z"),
+			expectError: true,
+		},
+		"invalid empty class": {
+			val:         types.StringValue("[]"),
+			expectError: true,
+		},
+		"invalid class range": {
+			val:         types.StringValue("[a-]"),
+			expectError: true,
+		},
+		"invalid trailing backslash": {
+			val:         types.StringValue(`logs\`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsGlobPattern().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsGlobPattern().ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
+
+func TestIsGlobPatternValidator_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val      types.String
+		expected diag.Diagnostics
+	}
+
+	tests := map[string]testCase{
+		"unterminated class": {
+			val: types.StringValue("logs/[a-z"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a valid glob pattern: unterminated character class at offset 5, got: "logs/[a-z"`,
+				),
+			},
+		},
+		"empty class": {
+			val: types.StringValue("a[^]b"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a valid glob pattern: empty character class at offset 3, got: "a[^]b"`,
+				),
+			},
+		},
+		"unexpected class character": {
+			val: types.StringValue("[a-]"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a valid glob pattern: unexpected ']' in character class at offset 3, got: "[a-]"`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsGlobPattern().ValidateString(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
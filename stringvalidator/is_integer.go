@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+var _ validator.String = isIntegerValidator{}
+var _ function.StringParameterValidator = isIntegerValidator{}
+var _ validatorspec.ValidatorWithConstraint = isIntegerValidator{}
+
+type isIntegerValidator struct {
+	minValue, maxValue int64
+}
+
+func (v isIntegerValidator) invalidUsageMessage() string {
+	return fmt.Sprintf("minValue cannot be greater than maxValue - minValue: %d, maxValue: %d", v.minValue, v.maxValue)
+}
+
+func (v isIntegerValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a base 10 integer between %d and %d", v.minValue, v.maxValue)
+}
+
+func (v isIntegerValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isIntegerValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatInteger,
+		Min:    new(big.Float).SetInt64(v.minValue),
+		Max:    new(big.Float).SetInt64(v.maxValue),
+	}
+}
+
+func (v isIntegerValidator) valid(value string) bool {
+	n, err := strconv.ParseInt(value, 10, 64)
+
+	return err == nil && n >= v.minValue && n <= v.maxValue
+}
+
+func (v isIntegerValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.minValue > v.maxValue {
+		response.Diagnostics.Append(
+			validatordiag.InvalidValidatorUsageDiagnostic(
+				request.Path,
+				"IsInteger",
+				v.invalidUsageMessage(),
+			),
+		)
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if !v.valid(value) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q", value),
+		))
+	}
+}
+
+func (v isIntegerValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.minValue > v.maxValue {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			request.ArgumentPosition,
+			"IsInteger",
+			v.invalidUsageMessage(),
+		)
+
+		return
+	}
+
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	value := request.Value.ValueString()
+
+	if !v.valid(value) {
+		response.Error = validatorfuncerr.InvalidParameterValueFuncError(
+			request.ArgumentPosition,
+			v.Description(ctx),
+			fmt.Sprintf("%q", value),
+		)
+	}
+}
+
+// IsInteger returns a validator which ensures that any configured attribute
+// or function parameter value is a base 10 integer, such as "1024" or "-5",
+// greater than or equal to the given minimum and less than or equal to the
+// given maximum. Use math.MinInt64 or math.MaxInt64 for an unbounded
+// minimum or maximum. Null (unconfigured) and unknown (known after apply)
+// values are skipped.
+//
+// minValue cannot be greater than maxValue. Invalid combinations of
+// minValue and maxValue will result in an implementation error message during
+// validation.
+func IsInteger(minValue, maxValue int64) isIntegerValidator {
+	return isIntegerValidator{
+		minValue: minValue,
+		maxValue: maxValue,
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsIntegerValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		minValue    int64
+		maxValue    int64
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:      types.StringUnknown(),
+			minValue: 0,
+			maxValue: 10,
+		},
+		"null String": {
+			val:      types.StringNull(),
+			minValue: 0,
+			maxValue: 10,
+		},
+		"valid": {
+			val:      types.StringValue("1024"),
+			minValue: 0,
+			maxValue: 2048,
+		},
+		"valid negative": {
+			val:      types.StringValue("-5"),
+			minValue: -10,
+			maxValue: 10,
+		},
+		"valid plus sign": {
+			val:      types.StringValue("+5"),
+			minValue: 0,
+			maxValue: 10,
+		},
+		"valid minimum": {
+			val:      types.StringValue("0"),
+			minValue: 0,
+			maxValue: 10,
+		},
+		"valid maximum": {
+			val:      types.StringValue("10"),
+			minValue: 0,
+			maxValue: 10,
+		},
+		"valid unbounded": {
+			val:      types.StringValue("-9223372036854775808"),
+			minValue: math.MinInt64,
+			maxValue: math.MaxInt64,
+		},
+		"invalid below minimum": {
+			val:         types.StringValue("-1"),
+			minValue:    0,
+			maxValue:    10,
+			expectError: true,
+		},
+		"invalid above maximum": {
+			val:         types.StringValue("11"),
+			minValue:    0,
+			maxValue:    10,
+			expectError: true,
+		},
+		"invalid out of int64 range": {
+			val:         types.StringValue("9223372036854775808"),
+			minValue:    math.MinInt64,
+			maxValue:    math.MaxInt64,
+			expectError: true,
+		},
+		"invalid decimal": {
+			val:         types.StringValue("1.5"),
+			minValue:    0,
+			maxValue:    10,
+			expectError: true,
+		},
+		"invalid exponent": {
+			val:         types.StringValue("1e3"),
+			minValue:    0,
+			maxValue:    10000,
+			expectError: true,
+		},
+		"invalid whitespace": {
+			val:         types.StringValue(" 5"),
+			minValue:    0,
+			maxValue:    10,
+			expectError: true,
+		},
+		"invalid empty": {
+			val:         types.StringValue(""),
+			minValue:    0,
+			maxValue:    10,
+			expectError: true,
+		},
+		"invalid unit": {
+			val:         types.StringValue("5GiB"),
+			minValue:    0,
+			maxValue:    10,
+			expectError: true,
+		},
+		"invalid validator usage - minValue > maxValue": {
+			val:         types.StringValue("5"),
+			minValue:    10,
+			maxValue:    0,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsInteger(test.minValue, test.maxValue).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsInteger(test.minValue, test.maxValue).ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+var _ validator.String = isPEMValidator{}
+var _ function.StringParameterValidator = isPEMValidator{}
+var _ validatorspec.ValidatorWithConstraint = isPEMValidator{}
+
+type isPEMValidator struct {
+	blockTypes []string
+}
+
+func (v isPEMValidator) Description(_ context.Context) string {
+	if len(v.blockTypes) == 0 {
+		return "value must contain one or more PEM encoded blocks"
+	}
+
+	return fmt.Sprintf("value must contain one or more PEM encoded blocks of type: %q", v.blockTypes)
+}
+
+func (v isPEMValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isPEMValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatPEM,
+		Values: validatorspec.StringValues(v.blockTypes...),
+	}
+}
+
+func (v isPEMValidator) validate(ctx context.Context, value string) []validationFailure {
+	blocks, failure := decodePEMBlocks(ctx, v, value)
+
+	if failure != nil {
+		return []validationFailure{*failure}
+	}
+
+	if len(v.blockTypes) == 0 {
+		return nil
+	}
+
+	var failures []validationFailure
+
+	for index, block := range blocks {
+		if !slices.Contains(v.blockTypes, block.Type) {
+			failures = append(failures, validationFailure{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("block %d of type %q", index, block.Type),
+			})
+		}
+	}
+
+	return failures
+}
+
+func (v isPEMValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isPEMValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsPEM returns a validator which ensures that any configured attribute or
+// function parameter value consists of one or more PEM encoded blocks, as
+// defined by RFC 7468, optionally separated by whitespace. If any blockTypes
+// are given, such as "CERTIFICATE", every block must be of one of the given
+// types. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+//
+// Diagnostics never include the block contents.
+func IsPEM(blockTypes ...string) isPEMValidator {
+	return isPEMValidator{
+		blockTypes: blockTypes,
+	}
+}
+
+// decodePEMBlocks decodes every PEM block of the given value. A failure is
+// returned if the value contains no PEM blocks or if it contains any
+// non-whitespace data outside of PEM blocks. The failure description is taken
+// from the given validator and the failure value never includes any of the
+// given value, as it may contain key material.
+func decodePEMBlocks(ctx context.Context, v validator.Describer, value string) ([]*pem.Block, *validationFailure) {
+	var blocks []*pem.Block
+
+	rest := []byte(value)
+
+	for {
+		block, remaining := pem.Decode(rest)
+
+		if block == nil {
+			break
+		}
+
+		// pem.Decode silently skips any data preceding a block, including
+		// malformed blocks, so everything before the BEGIN line of the decoded
+		// block, which is the last one before its END line, must be whitespace.
+		consumed := string(rest[:len(rest)-len(remaining)])
+		consumed = consumed[:strings.LastIndex(consumed, "-----END ")]
+
+		if before := consumed[:strings.LastIndex(consumed, "-----BEGIN ")]; strings.TrimSpace(before) != "" {
+			return nil, &validationFailure{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("unexpected data before PEM block %d", len(blocks)),
+			}
+		}
+
+		blocks = append(blocks, block)
+		rest = remaining
+	}
+
+	if len(blocks) == 0 {
+		return nil, &validationFailure{
+			description: v.Description(ctx),
+			value:       "no PEM encoded blocks",
+		}
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, &validationFailure{
+			description: v.Description(ctx),
+			value:       fmt.Sprintf("unexpected data after PEM block %d", len(blocks)-1),
+		}
+	}
+
+	return blocks, nil
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+var _ validator.String = isPrivateKeyValidator{}
+var _ function.StringParameterValidator = isPrivateKeyValidator{}
+var _ validatorspec.ValidatorWithConstraint = isPrivateKeyValidator{}
+
+type isPrivateKeyValidator struct{}
+
+func (v isPrivateKeyValidator) Description(_ context.Context) string {
+	return "value must be a single unencrypted PEM encoded PKCS #1, PKCS #8, or EC private key"
+}
+
+func (v isPrivateKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isPrivateKeyValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatPrivateKey,
+	}
+}
+
+func (v isPrivateKeyValidator) validate(ctx context.Context, value string) []validationFailure {
+	blocks, failure := decodePEMBlocks(ctx, v, value)
+
+	if failure != nil {
+		return []validationFailure{*failure}
+	}
+
+	if len(blocks) != 1 {
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("%d PEM blocks", len(blocks)),
+			},
+		}
+	}
+
+	block := blocks[0]
+
+	if block.Type == "ENCRYPTED PRIVATE KEY" || block.Headers["Proc-Type"] == "4,ENCRYPTED" {
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       "encrypted private key",
+			},
+		}
+	}
+
+	var err error
+
+	// Parser errors are intentionally not included in diagnostics to prevent
+	// any possibility of echoing key material.
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		_, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		_, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		_, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("PEM block of type %q", block.Type),
+			},
+		}
+	}
+
+	if err != nil {
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("unparseable %q PEM block", block.Type),
+			},
+		}
+	}
+
+	return nil
+}
+
+func (v isPrivateKeyValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isPrivateKeyValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsPrivateKey returns a validator which ensures that any configured attribute
+// or function parameter value is a single unencrypted PEM encoded private key
+// in one of the following formats:
+//
+//   - PKCS #1 RSA private key ("RSA PRIVATE KEY")
+//   - PKCS #8 private key ("PRIVATE KEY")
+//   - SEC 1 EC private key ("EC PRIVATE KEY")
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Diagnostics never include the key material or parser errors.
+func IsPrivateKey() isPrivateKeyValidator {
+	return isPrivateKeyValidator{}
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsPrivateKeyValidator(t *testing.T) {
+	t.Parallel()
+
+	ecdsaKey := testECDSAKey(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+
+	ecDER, err := x509.MarshalECPrivateKey(ecdsaKey)
+
+	if err != nil {
+		t.Fatalf("unable to marshal key: %s", err)
+	}
+
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(ecdsaKey)
+
+	if err != nil {
+		t.Fatalf("unable to marshal key: %s", err)
+	}
+
+	pkcs1PEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}))
+	pkcs8PEM := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8DER}))
+	ecPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDER}))
+	certPEM, _ := testCertificate(t, ecdsaKey, false, nil, nil)
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid PKCS1": {
+			val: types.StringValue(pkcs1PEM),
+		},
+		"valid PKCS8": {
+			val: types.StringValue(pkcs8PEM),
+		},
+		"valid EC": {
+			val: types.StringValue(ecPEM),
+		},
+		"invalid mismatched block type": {
+			val:         types.StringValue(strings.ReplaceAll(ecPEM, "EC PRIVATE KEY", "RSA PRIVATE KEY")),
+			expectError: true,
+		},
+		"invalid encrypted": {
+			val:         types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: pkcs8DER}))),
+			expectError: true,
+		},
+		"invalid legacy encrypted": {
+			val: types.StringValue(string(pem.EncodeToMemory(&pem.Block{
+				Type:    "RSA PRIVATE KEY",
+				Headers: map[string]string{"Proc-Type": "4,ENCRYPTED"},
+				Bytes:   x509.MarshalPKCS1PrivateKey(rsaKey),
+			}))),
+			expectError: true,
+		},
+		"invalid certificate": {
+			val:         types.StringValue(certPEM),
+			expectError: true,
+		},
+		"invalid multiple keys": {
+			val:         types.StringValue(pkcs8PEM + ecPEM),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsPrivateKey().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			// Key material must never be echoed.
+			for _, d := range response.Diagnostics {
+				if strings.Contains(d.Detail(), "-----") || strings.Contains(d.Detail(), strings.Split(test.val.ValueString(), "\n")[1]) {
+					t.Fatalf("diagnostic echoed key material: %s", d.Detail())
+				}
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsPrivateKey().ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// QuantityUnitSystem is the set of units accepted by the IsQuantity
+// validator.
+type QuantityUnitSystem int
+
+const (
+	// QuantityUnitSystemBytes accepts byte sizes with SI (powers of 1000)
+	// or IEC (powers of 1024) units, such as "512B", "1.5TB", or "10GiB". A
+	// value without a unit is a number of bytes. A single space is allowed
+	// between the number and unit.
+	//
+	// The SI units are kB (or KB), MB, GB, TB, PB, and EB. The IEC units are
+	// KiB, MiB, GiB, TiB, PiB, and EiB.
+	QuantityUnitSystemBytes QuantityUnitSystem = iota
+
+	// QuantityUnitSystemBitRate accepts bandwidths with SI (powers of 1000)
+	// units, such as "100Mbps" or "1.5Gbps". A value without a unit is a
+	// number of bits per second. A single space is allowed between the
+	// number and unit.
+	//
+	// The units are bps, kbps (or Kbps), Mbps, Gbps, Tbps, and Pbps.
+	QuantityUnitSystemBitRate
+
+	// QuantityUnitSystemKubernetes accepts Kubernetes resource quantities,
+	// such as "500m", "128Mi", "2G", or "1e3". A quantity is an optionally
+	// signed decimal number followed by an optional suffix, which is a
+	// binary SI suffix (Ki, Mi, Gi, Ti, Pi, Ei), a decimal SI suffix (n, u,
+	// m, k, M, G, T, P, E), or a decimal exponent (e3 or E-3).
+	QuantityUnitSystemKubernetes
+)
+
+// quantityUnitSystem describes the syntax and units of a QuantityUnitSystem.
+type quantityUnitSystem struct {
+	name    string
+	example string
+
+	// pattern matches a quantity, capturing the number and unit.
+	pattern *regexp.Regexp
+
+	// units are the multipliers of each accepted unit, including the empty
+	// unit.
+	units map[string]*big.Rat
+
+	// allowExponent enables decimal exponent units, such as "e3".
+	allowExponent bool
+}
+
+// quantityMaxExponent is the largest supported absolute decimal exponent of a
+// Kubernetes quantity, which prevents excessive memory usage by exact
+// comparisons.
+const quantityMaxExponent = 308
+
+var quantityUnitSystems = map[QuantityUnitSystem]quantityUnitSystem{
+	QuantityUnitSystemBytes: {
+		name:    "byte size",
+		example: "10GiB",
+		pattern: regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?) ?([A-Za-z]*)$`),
+		units: quantityUnits(map[string]string{
+			"":    "1",
+			"B":   "1",
+			"kB":  "1e3",
+			"KB":  "1e3",
+			"MB":  "1e6",
+			"GB":  "1e9",
+			"TB":  "1e12",
+			"PB":  "1e15",
+			"EB":  "1e18",
+			"KiB": "1024",
+			"MiB": "1048576",
+			"GiB": "1073741824",
+			"TiB": "1099511627776",
+			"PiB": "1125899906842624",
+			"EiB": "1152921504606846976",
+		}),
+	},
+	QuantityUnitSystemBitRate: {
+		name:    "bit rate",
+		example: "100Mbps",
+		pattern: regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?) ?([A-Za-z]*)$`),
+		units: quantityUnits(map[string]string{
+			"":     "1",
+			"bps":  "1",
+			"kbps": "1e3",
+			"Kbps": "1e3",
+			"Mbps": "1e6",
+			"Gbps": "1e9",
+			"Tbps": "1e12",
+			"Pbps": "1e15",
+		}),
+	},
+	QuantityUnitSystemKubernetes: {
+		name:    "Kubernetes quantity",
+		example: "500Mi",
+		pattern: regexp.MustCompile(`^([+-]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+))([A-Za-z]*|[eE][+-]?[0-9]+)$`),
+		units: quantityUnits(map[string]string{
+			"":   "1",
+			"n":  "1e-9",
+			"u":  "1e-6",
+			"m":  "1e-3",
+			"k":  "1e3",
+			"M":  "1e6",
+			"G":  "1e9",
+			"T":  "1e12",
+			"P":  "1e15",
+			"E":  "1e18",
+			"Ki": "1024",
+			"Mi": "1048576",
+			"Gi": "1073741824",
+			"Ti": "1099511627776",
+			"Pi": "1125899906842624",
+			"Ei": "1152921504606846976",
+		}),
+		allowExponent: true,
+	},
+}
+
+// quantityUnits converts unit multipliers into exact rational values.
+func quantityUnits(multipliers map[string]string) map[string]*big.Rat {
+	units := make(map[string]*big.Rat, len(multipliers))
+
+	for unit, multiplier := range multipliers {
+		r, ok := new(big.Rat).SetString(multiplier)
+
+		if !ok {
+			panic(fmt.Sprintf("invalid quantity unit multiplier %q", multiplier))
+		}
+
+		units[unit] = r
+	}
+
+	return units
+}
+
+// parse returns the magnitude of the quantity in the base unit of the unit
+// system.
+func (s quantityUnitSystem) parse(value string) (*big.Rat, bool) {
+	matches := s.pattern.FindStringSubmatch(value)
+
+	if matches == nil {
+		return nil, false
+	}
+
+	number, unit := matches[1], matches[2]
+
+	// A trailing decimal point, such as "1.", is accepted by the Kubernetes
+	// syntax but not by big.Rat.
+	n, ok := new(big.Rat).SetString(strings.TrimSuffix(number, "."))
+
+	if !ok {
+		return nil, false
+	}
+
+	if multiplier, ok := s.units[unit]; ok {
+		return n.Mul(n, multiplier), true
+	}
+
+	if !s.allowExponent || (unit[0] != 'e' && unit[0] != 'E') {
+		return nil, false
+	}
+
+	exponent, err := strconv.Atoi(unit[1:])
+
+	if err != nil || exponent > quantityMaxExponent || exponent < -quantityMaxExponent {
+		return nil, false
+	}
+
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(exponent, -exponent))), nil))
+
+	if exponent < 0 {
+		return n.Quo(n, scale), true
+	}
+
+	return n.Mul(n, scale), true
+}
+
+var _ validator.String = isQuantityValidator{}
+var _ function.StringParameterValidator = isQuantityValidator{}
+var _ validatorspec.ValidatorWithConstraint = isQuantityValidator{}
+
+type isQuantityValidator struct {
+	unitSystem         QuantityUnitSystem
+	minValue, maxValue string
+}
+
+// bounds returns the unit system and parsed bounds, or a message describing
+// why the validator is in an invalid state. Nil bounds are unbounded.
+func (v isQuantityValidator) bounds() (quantityUnitSystem, *big.Rat, *big.Rat, string) {
+	system, ok := quantityUnitSystems[v.unitSystem]
+
+	if !ok {
+		return system, nil, nil, fmt.Sprintf("unitSystem %d is not a supported QuantityUnitSystem", v.unitSystem)
+	}
+
+	var minValue, maxValue *big.Rat
+
+	if v.minValue != "" {
+		if minValue, ok = system.parse(v.minValue); !ok {
+			return system, nil, nil, fmt.Sprintf("minValue must be a valid %s, got: %q", system.name, v.minValue)
+		}
+	}
+
+	if v.maxValue != "" {
+		if maxValue, ok = system.parse(v.maxValue); !ok {
+			return system, nil, nil, fmt.Sprintf("maxValue must be a valid %s, got: %q", system.name, v.maxValue)
+		}
+	}
+
+	if minValue != nil && maxValue != nil && minValue.Cmp(maxValue) > 0 {
+		return system, nil, nil, fmt.Sprintf("minValue cannot be greater than maxValue - minValue: %q, maxValue: %q", v.minValue, v.maxValue)
+	}
+
+	return system, minValue, maxValue, ""
+}
+
+func (v isQuantityValidator) Description(_ context.Context) string {
+	system, ok := quantityUnitSystems[v.unitSystem]
+
+	if !ok {
+		return "value must be a quantity"
+	}
+
+	description := fmt.Sprintf("value must be a %s, such as %q", system.name, system.example)
+
+	switch {
+	case v.minValue != "" && v.maxValue != "":
+		return fmt.Sprintf("%s, between %s and %s", description, v.minValue, v.maxValue)
+	case v.minValue != "":
+		return fmt.Sprintf("%s, of at least %s", description, v.minValue)
+	case v.maxValue != "":
+		return fmt.Sprintf("%s, of at most %s", description, v.maxValue)
+	default:
+		return description
+	}
+}
+
+func (v isQuantityValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isQuantityValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	constraint := validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatQuantity,
+	}
+
+	system, ok := quantityUnitSystems[v.unitSystem]
+
+	if !ok {
+		return constraint
+	}
+
+	// Each bound is converted separately, rather than with bounds, so a
+	// minValue greater than maxValue is still described.
+	if minValue, ok := system.parse(v.minValue); ok {
+		constraint.Min = new(big.Float).SetRat(minValue)
+	}
+
+	if maxValue, ok := system.parse(v.maxValue); ok {
+		constraint.Max = new(big.Float).SetRat(maxValue)
+	}
+
+	return constraint
+}
+
+func (v isQuantityValidator) validate(ctx context.Context, system quantityUnitSystem, minValue, maxValue *big.Rat, value string) []validationFailure {
+	n, ok := system.parse(value)
+
+	if ok && (minValue == nil || n.Cmp(minValue) >= 0) && (maxValue == nil || n.Cmp(maxValue) <= 0) {
+		return nil
+	}
+
+	return []validationFailure{
+		{
+			description: v.Description(ctx),
+			value:       fmt.Sprintf("%q", value),
+		},
+	}
+}
+
+func (v isQuantityValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	system, minValue, maxValue, invalidUsage := v.bounds()
+
+	// Return an error if the validator has been created in an invalid state
+	if invalidUsage != "" {
+		response.Diagnostics.Append(
+			validatordiag.InvalidValidatorUsageDiagnostic(
+				request.Path,
+				"IsQuantity",
+				invalidUsage,
+			),
+		)
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, system, minValue, maxValue, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isQuantityValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	system, minValue, maxValue, invalidUsage := v.bounds()
+
+	// Return an error if the validator has been created in an invalid state
+	if invalidUsage != "" {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			request.ArgumentPosition,
+			"IsQuantity",
+			invalidUsage,
+		)
+
+		return
+	}
+
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, system, minValue, maxValue, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsQuantity returns a validator which ensures that any configured attribute
+// or function parameter value is a quantity in the given unit system, such as
+// "10GiB" for QuantityUnitSystemBytes or "500Mi" for
+// QuantityUnitSystemKubernetes, with a magnitude greater than or equal to
+// minValue and less than or equal to maxValue. Null (unconfigured) and unknown
+// (known after apply) values are skipped.
+//
+// The bounds are quantities in the same unit system, such as "1GiB" and
+// "1TB", and are compared exactly by magnitude, so "1GiB" is greater than
+// "1GB". An empty bound is unbounded.
+//
+// Bounds which are not valid quantities, or a minValue greater than maxValue,
+// will result in an implementation error message during validation.
+func IsQuantity(unitSystem QuantityUnitSystem, minValue, maxValue string) isQuantityValidator {
+	return isQuantityValidator{
+		unitSystem: unitSystem,
+		minValue:   minValue,
+		maxValue:   maxValue,
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleIsQuantity() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Validate this string is a byte size between 1GiB and 1TiB.
+					stringvalidator.IsQuantity(stringvalidator.QuantityUnitSystemBytes, "1GiB", "1TiB"),
+				},
+			},
+		},
+	}
+}
+
+func ExampleIsQuantity_function() {
+	_ = function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "example_param",
+				Validators: []function.StringParameterValidator{
+					// Validate this string is a byte size between 1GiB and 1TiB.
+					stringvalidator.IsQuantity(stringvalidator.QuantityUnitSystemBytes, "1GiB", "1TiB"),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsQuantityValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		unitSystem  stringvalidator.QuantityUnitSystem
+		minValue    string
+		maxValue    string
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:        types.StringUnknown(),
+			unitSystem: stringvalidator.QuantityUnitSystemBytes,
+			minValue:   "1GiB",
+			maxValue:   "1TiB",
+		},
+		"null String": {
+			val:        types.StringNull(),
+			unitSystem: stringvalidator.QuantityUnitSystemBytes,
+			minValue:   "1GiB",
+			maxValue:   "1TiB",
+		},
+		"bytes valid IEC": {
+			val:        types.StringValue("10GiB"),
+			unitSystem: stringvalidator.QuantityUnitSystemBytes,
+			minValue:   "1GiB",
+			maxValue:   "1TiB",
+		},
+		"bytes valid SI": {
+			val:        types.StringValue("1.5TB"),
+			unitSystem: stringvalidator.QuantityUnitSystemBytes,
+			minValue:   "",
+			maxValue:   "",
+		},
+		"bytes valid space": {
+			val:        types.StringValue("512 MB"),
+			unitSystem: stringvalidator.QuantityUnitSystemBytes,
+			minValue:   "",
+			maxValue:   "",
+		},
+		"bytes valid bare number": {
+			val:        types.StringValue("1024"),
+			unitSystem: stringvalidator.QuantityUnitSystemBytes,
+			minValue:   "1KiB",
+			maxValue:   "1KiB",
+		},
+		"bytes valid minimum mixed units": {
+			val:        types.StringValue("1024MiB"),
+			unitSystem: stringvalidator.QuantityUnitSystemBytes,
+			minValue:   "1GiB",
+			maxValue:   "",
+		},
+		"bytes valid maximum": {
+			val:        types.StringValue("1TiB"),
+			unitSystem: stringvalidator.QuantityUnitSystemBytes,
+			minValue:   "",
+			maxValue:   "1TiB",
+		},
+		"bytes invalid below minimum": {
+			val:         types.StringValue("1GB"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "1GiB",
+			maxValue:    "",
+			expectError: true,
+		},
+		"bytes invalid above maximum": {
+			val:         types.StringValue("1.1TB"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "",
+			maxValue:    "1TiB",
+			expectError: true,
+		},
+		"bytes invalid unit": {
+			val:         types.StringValue("10Gi"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "",
+			maxValue:    "",
+			expectError: true,
+		},
+		"bytes invalid lowercase unit": {
+			val:         types.StringValue("10gib"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "",
+			maxValue:    "",
+			expectError: true,
+		},
+		"bytes invalid negative": {
+			val:         types.StringValue("-1GB"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "",
+			maxValue:    "",
+			expectError: true,
+		},
+		"bytes invalid exponent": {
+			val:         types.StringValue("1e3"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "",
+			maxValue:    "",
+			expectError: true,
+		},
+		"bytes invalid empty": {
+			val:         types.StringValue(""),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "",
+			maxValue:    "",
+			expectError: true,
+		},
+		"bit rate valid": {
+			val:        types.StringValue("100Mbps"),
+			unitSystem: stringvalidator.QuantityUnitSystemBitRate,
+			minValue:   "1Mbps",
+			maxValue:   "10Gbps",
+		},
+		"bit rate valid space": {
+			val:        types.StringValue("1.5 Gbps"),
+			unitSystem: stringvalidator.QuantityUnitSystemBitRate,
+			minValue:   "",
+			maxValue:   "",
+		},
+		"bit rate invalid below minimum": {
+			val:         types.StringValue("999kbps"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBitRate,
+			minValue:    "1Mbps",
+			maxValue:    "",
+			expectError: true,
+		},
+		"bit rate invalid byte unit": {
+			val:         types.StringValue("100MB"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBitRate,
+			minValue:    "",
+			maxValue:    "",
+			expectError: true,
+		},
+		"kubernetes valid binary suffix": {
+			val:        types.StringValue("500Mi"),
+			unitSystem: stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:   "128Mi",
+			maxValue:   "1Gi",
+		},
+		"kubernetes valid decimal suffix": {
+			val:        types.StringValue("2G"),
+			unitSystem: stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:   "",
+			maxValue:   "",
+		},
+		"kubernetes valid milli": {
+			val:        types.StringValue("500m"),
+			unitSystem: stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:   "100m",
+			maxValue:   "2",
+		},
+		"kubernetes valid exponent": {
+			val:        types.StringValue("1e3"),
+			unitSystem: stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:   "1k",
+			maxValue:   "1k",
+		},
+		"kubernetes valid negative exponent": {
+			val:        types.StringValue("5E-1"),
+			unitSystem: stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:   "500m",
+			maxValue:   "500m",
+		},
+		"kubernetes valid signed": {
+			val:        types.StringValue("-1.5"),
+			unitSystem: stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:   "-2",
+			maxValue:   "",
+		},
+		"kubernetes valid leading decimal point": {
+			val:        types.StringValue(".5Gi"),
+			unitSystem: stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:   "512Mi",
+			maxValue:   "512Mi",
+		},
+		"kubernetes invalid below minimum": {
+			val:         types.StringValue("99m"),
+			unitSystem:  stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:    "100m",
+			maxValue:    "",
+			expectError: true,
+		},
+		"kubernetes invalid above maximum": {
+			val:         types.StringValue("1.1Gi"),
+			unitSystem:  stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:    "",
+			maxValue:    "1Gi",
+			expectError: true,
+		},
+		"kubernetes invalid suffix": {
+			val:         types.StringValue("10GiB"),
+			unitSystem:  stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:    "",
+			maxValue:    "",
+			expectError: true,
+		},
+		"kubernetes invalid exponent out of range": {
+			val:         types.StringValue("1e1000"),
+			unitSystem:  stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:    "",
+			maxValue:    "",
+			expectError: true,
+		},
+		"kubernetes invalid space": {
+			val:         types.StringValue("10 Gi"),
+			unitSystem:  stringvalidator.QuantityUnitSystemKubernetes,
+			minValue:    "",
+			maxValue:    "",
+			expectError: true,
+		},
+		"invalid validator usage - minValue": {
+			val:         types.StringValue("10GiB"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "1Gi",
+			maxValue:    "",
+			expectError: true,
+		},
+		"invalid validator usage - maxValue": {
+			val:         types.StringValue("10GiB"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "",
+			maxValue:    "lots",
+			expectError: true,
+		},
+		"invalid validator usage - minValue > maxValue": {
+			val:         types.StringValue("10GiB"),
+			unitSystem:  stringvalidator.QuantityUnitSystemBytes,
+			minValue:    "1TiB",
+			maxValue:    "1GiB",
+			expectError: true,
+		},
+		"invalid validator usage - unit system": {
+			val:         types.StringValue("10GiB"),
+			unitSystem:  stringvalidator.QuantityUnitSystem(99),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsQuantity(test.unitSystem, test.minValue, test.maxValue).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsQuantity(test.unitSystem, test.minValue, test.maxValue).ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
+
+func TestIsQuantityValidator_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	request := validator.StringRequest{
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+		ConfigValue:    types.StringValue("2TB"),
+	}
+	response := validator.StringResponse{}
+
+	stringvalidator.IsQuantity(stringvalidator.QuantityUnitSystemBytes, "1GiB", "1TiB").ValidateString(context.Background(), request, &response)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value",
+			`Attribute test value must be a byte size, such as "10GiB", between 1GiB and 1TiB, got: "2TB"`,
+		),
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// RegexOptions are the optional complexity limits of the IsRegex validator.
+// Zero values apply no additional limit.
+type RegexOptions struct {
+	// MaxRepeat is the maximum count allowed in a repetition, such as
+	// a{2,5}. Go already rejects counts greater than 1000.
+	MaxRepeat int
+
+	// MaxProgramSize is the maximum number of instructions in the compiled
+	// program, which is a measure of the memory and time required to match.
+	MaxProgramSize int
+}
+
+var _ validator.String = isRegexValidator{}
+var _ function.StringParameterValidator = isRegexValidator{}
+var _ validatorspec.ValidatorWithConstraint = isRegexValidator{}
+
+type isRegexValidator struct {
+	opts RegexOptions
+}
+
+func (v isRegexValidator) Description(_ context.Context) string {
+	return "value must be a valid RE2 regular expression"
+}
+
+func (v isRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isRegexValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatRegex,
+	}
+}
+
+func (v isRegexValidator) validate(ctx context.Context, value string) []validationFailure {
+	// Use the same flags as regexp.Compile.
+	re, err := syntax.Parse(value, syntax.Perl)
+
+	if err != nil {
+		reason := err.Error()
+
+		var syntaxErr *syntax.Error
+
+		if errors.As(err, &syntaxErr) {
+			reason = fmt.Sprintf("%s: `%s`", syntaxErr.Code, syntaxErr.Expr)
+
+			if offset := strings.Index(value, syntaxErr.Expr); offset >= 0 {
+				reason += fmt.Sprintf(" at offset %d", offset)
+			}
+		}
+
+		return []validationFailure{
+			{
+				description: fmt.Sprintf("%s: %s", v.Description(ctx), reason),
+				value:       fmt.Sprintf("%q", value),
+			},
+		}
+	}
+
+	var failures []validationFailure
+
+	if v.opts.MaxRepeat > 0 {
+		if repeat := regexMaxRepeat(re); repeat > v.opts.MaxRepeat {
+			failures = append(failures, validationFailure{
+				description: fmt.Sprintf("regular expression repetition count must be at most %d", v.opts.MaxRepeat),
+				value:       fmt.Sprintf("%d", repeat),
+			})
+		}
+	}
+
+	if v.opts.MaxProgramSize > 0 {
+		prog, err := syntax.Compile(re.Simplify())
+
+		if err != nil {
+			failures = append(failures, validationFailure{
+				description: v.Description(ctx),
+				value:       err.Error(),
+			})
+		} else if size := len(prog.Inst); size > v.opts.MaxProgramSize {
+			failures = append(failures, validationFailure{
+				description: fmt.Sprintf("regular expression program size must be at most %d instructions", v.opts.MaxProgramSize),
+				value:       fmt.Sprintf("%d", size),
+			})
+		}
+	}
+
+	return failures
+}
+
+func (v isRegexValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isRegexValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsRegex returns a validator which ensures that any configured attribute or
+// function parameter value is a valid regular expression using the RE2 syntax
+// accepted by the Go regexp package: https://github.com/google/re2/wiki/Syntax.
+// Optional complexity limits can be given via RegexOptions. Null
+// (unconfigured) and unknown (known after apply) values are skipped.
+//
+// Diagnostics include the parser error and, where possible, the offset of
+// the invalid portion of the expression.
+func IsRegex(opts RegexOptions) isRegexValidator {
+	return isRegexValidator{
+		opts: opts,
+	}
+}
+
+// regexMaxRepeat returns the largest repetition count in the expression. An
+// unbounded repetition, such as a{2,}, counts its minimum.
+func regexMaxRepeat(re *syntax.Regexp) int {
+	result := 0
+
+	if re.Op == syntax.OpRepeat {
+		result = max(re.Min, re.Max)
+	}
+
+	for _, sub := range re.Sub {
+		result = max(result, regexMaxRepeat(sub))
+	}
+
+	return result
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsRegexValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		opts        stringvalidator.RegexOptions
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue(`^[a-z]+(-[a-z0-9]+)*$`),
+		},
+		"valid empty": {
+			val: types.StringValue(""),
+		},
+		"valid named group": {
+			val: types.StringValue(`(?P<name>\w+)`),
+		},
+		"invalid missing paren": {
+			val:         types.StringValue(`(abc`),
+			expectError: true,
+		},
+		"invalid lookahead": {
+			val:         types.StringValue(`foo(?=bar)`),
+			expectError: true,
+		},
+		"invalid repeat count": {
+			val:         types.StringValue(`a{1001}`),
+			expectError: true,
+		},
+		"valid max repeat": {
+			val:  types.StringValue(`a{2,10}`),
+			opts: stringvalidator.RegexOptions{MaxRepeat: 10},
+		},
+		"invalid max repeat": {
+			val:         types.StringValue(`a{2,11}`),
+			opts:        stringvalidator.RegexOptions{MaxRepeat: 10},
+			expectError: true,
+		},
+		"invalid max repeat unbounded": {
+			val:         types.StringValue(`a{20,}`),
+			opts:        stringvalidator.RegexOptions{MaxRepeat: 10},
+			expectError: true,
+		},
+		"valid max program size": {
+			val:  types.StringValue(`abc`),
+			opts: stringvalidator.RegexOptions{MaxProgramSize: 10},
+		},
+		"invalid max program size": {
+			val:         types.StringValue(`(a|b){50}`),
+			opts:        stringvalidator.RegexOptions{MaxProgramSize: 100},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsRegex(test.opts).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsRegex(test.opts).ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
+
+func TestIsRegexValidator_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	request := validator.StringRequest{
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+		ConfigValue:    types.StringValue("ab[c"),
+	}
+	response := validator.StringResponse{}
+
+	stringvalidator.IsRegex(stringvalidator.RegexOptions{}).ValidateString(context.Background(), request, &response)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value",
+			"Attribute test value must be a valid RE2 regular expression: missing closing ]: `[c` at offset 2, got: \"ab[c\"",
+		),
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// defaultSSHMinRSABits is the minimum RSA modulus size, in bits, accepted by
+// the IsSSHPublicKey and IsAuthorizedKeysLine validators.
+const defaultSSHMinRSABits = 2048
+
+// sshKeyTypes are the supported public key types, mapped to the curve
+// identifier expected within the key data for ECDSA types.
+var sshKeyTypes = map[string]string{
+	"ssh-rsa":                            "",
+	"ssh-ed25519":                        "",
+	"ecdsa-sha2-nistp256":                "nistp256",
+	"ecdsa-sha2-nistp384":                "nistp384",
+	"ecdsa-sha2-nistp521":                "nistp521",
+	"sk-ssh-ed25519@openssh.com":         "",
+	"sk-ecdsa-sha2-nistp256@openssh.com": "nistp256",
+}
+
+var _ validator.String = isSSHPublicKeyValidator{}
+var _ function.StringParameterValidator = isSSHPublicKeyValidator{}
+var _ validatorspec.ValidatorWithConstraint = isSSHPublicKeyValidator{}
+
+type isSSHPublicKeyValidator struct {
+	allowedTypes []string
+	minRSABits   int
+
+	// authorizedKeys enables parsing of a leading options field, as allowed
+	// in OpenSSH authorized_keys files.
+	authorizedKeys bool
+}
+
+func (v isSSHPublicKeyValidator) Description(_ context.Context) string {
+	format := "an OpenSSH public key"
+
+	if v.authorizedKeys {
+		format = "an OpenSSH authorized_keys line"
+	}
+
+	if len(v.allowedTypes) > 0 {
+		format = fmt.Sprintf("%s of type: %q", format, v.allowedTypes)
+	}
+
+	if v.minRSABits != defaultSSHMinRSABits {
+		return fmt.Sprintf("value must be %s, with RSA keys of at least %d bits", format, v.minRSABits)
+	}
+
+	return fmt.Sprintf("value must be %s", format)
+}
+
+// name returns the name of the validator for invalid usage messages.
+func (v isSSHPublicKeyValidator) name() string {
+	if v.authorizedKeys {
+		return "IsAuthorizedKeysLineWithMinRSABits"
+	}
+
+	return "IsSSHPublicKeyWithMinRSABits"
+}
+
+// invalidUsageMessage returns an error message if the options are invalid.
+func (v isSSHPublicKeyValidator) invalidUsageMessage() string {
+	if v.minRSABits < 1 {
+		return fmt.Sprintf("minRSABits must be positive, got: %d", v.minRSABits)
+	}
+
+	return ""
+}
+
+func (v isSSHPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isSSHPublicKeyValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	format := validatorspec.FormatSSHPublicKey
+
+	if v.authorizedKeys {
+		format = validatorspec.FormatAuthorizedKeysLine
+	}
+
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: format,
+		Values: validatorspec.StringValues(v.allowedTypes...),
+	}
+}
+
+func (v isSSHPublicKeyValidator) validate(ctx context.Context, value string) []validationFailure {
+	failure := func(reason string) []validationFailure {
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       reason,
+			},
+		}
+	}
+
+	if strings.Contains(value, "PRIVATE KEY-----") || strings.HasPrefix(value, "PuTTY-User-Key-File") {
+		return []validationFailure{
+			{
+				description: "value must be a public key, not a private key. Remove the private key from the configuration and provide the matching public key instead",
+				value:       "private key",
+			},
+		}
+	}
+
+	line := strings.TrimRight(value, "\r\n")
+
+	if strings.ContainsAny(line, "\r\n") {
+		return failure("multiple lines")
+	}
+
+	if v.authorizedKeys {
+		line = trimAuthorizedKeysOptions(line)
+	}
+
+	fields := strings.Fields(line)
+
+	if len(fields) < 2 {
+		return failure("missing key type or key data")
+	}
+
+	declaredType := fields[0]
+
+	if _, ok := sshKeyTypes[declaredType]; !ok {
+		return failure(fmt.Sprintf("unsupported key type %q", declaredType))
+	}
+
+	if len(v.allowedTypes) > 0 && !slices.Contains(v.allowedTypes, declaredType) {
+		return failure(fmt.Sprintf("key type %q", declaredType))
+	}
+
+	data, err := base64.StdEncoding.DecodeString(fields[1])
+
+	if err != nil {
+		return failure("key data is not valid base64, the key may be truncated")
+	}
+
+	embeddedType, rest, ok := sshReadString(data)
+
+	if !ok {
+		return failure("key data is truncated")
+	}
+
+	if string(embeddedType) != declaredType {
+		return failure(fmt.Sprintf("key type %q does not match embedded key type %q", declaredType, embeddedType))
+	}
+
+	if reason := sshValidateKeyData(declaredType, rest, v.minRSABits); reason != "" {
+		return failure(reason)
+	}
+
+	return nil
+}
+
+func (v isSSHPublicKeyValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if msg := v.invalidUsageMessage(); msg != "" {
+		response.Diagnostics.Append(validatordiag.InvalidValidatorUsageDiagnostic(request.Path, v.name(), msg))
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isSSHPublicKeyValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if msg := v.invalidUsageMessage(); msg != "" {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(request.ArgumentPosition, v.name(), msg)
+
+		return
+	}
+
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsSSHPublicKey returns a validator which ensures that any configured
+// attribute or function parameter value is a single OpenSSH public key in the
+// "type base64-data [comment]" format, such as the contents of an id_ed25519.pub
+// file. A single trailing newline is allowed. Null (unconfigured) and unknown
+// (known after apply) values are skipped.
+//
+// The key data is decoded and must match the declared key type. RSA keys must
+// be at least 2048 bits in size, use IsSSHPublicKeyWithMinRSABits for a
+// different minimum. If any allowedTypes are given, such as "ssh-ed25519",
+// the key type must be one of them. Supported key types are:
+//
+//   - ssh-rsa
+//   - ssh-ed25519
+//   - ecdsa-sha2-nistp256, ecdsa-sha2-nistp384, ecdsa-sha2-nistp521
+//   - sk-ssh-ed25519@openssh.com, sk-ecdsa-sha2-nistp256@openssh.com
+//
+// A specific error is returned when the value is a private key, without
+// echoing the value.
+func IsSSHPublicKey(allowedTypes ...string) isSSHPublicKeyValidator {
+	return IsSSHPublicKeyWithMinRSABits(defaultSSHMinRSABits, allowedTypes...)
+}
+
+// IsSSHPublicKeyWithMinRSABits returns a validator which ensures that any
+// configured attribute or function parameter value is a single OpenSSH public
+// key, in the same manner as IsSSHPublicKey, with RSA keys of at least the
+// given number of bits in size, such as 3072. Null (unconfigured) and unknown
+// (known after apply) values are skipped.
+func IsSSHPublicKeyWithMinRSABits(minRSABits int, allowedTypes ...string) isSSHPublicKeyValidator {
+	return isSSHPublicKeyValidator{
+		allowedTypes: allowedTypes,
+		minRSABits:   minRSABits,
+	}
+}
+
+// IsAuthorizedKeysLine returns a validator which ensures that any configured
+// attribute or function parameter value is a single OpenSSH authorized_keys
+// line in the "[options] type base64-data [comment]" format. The public key
+// is validated in the same manner as IsSSHPublicKey. Options, such as
+// `from="10.0.0.0/8",no-pty`, are not further validated. Null (unconfigured)
+// and unknown (known after apply) values are skipped.
+func IsAuthorizedKeysLine(allowedTypes ...string) isSSHPublicKeyValidator {
+	return IsAuthorizedKeysLineWithMinRSABits(defaultSSHMinRSABits, allowedTypes...)
+}
+
+// IsAuthorizedKeysLineWithMinRSABits returns a validator which ensures that
+// any configured attribute or function parameter value is a single OpenSSH
+// authorized_keys line, in the same manner as IsAuthorizedKeysLine, with RSA
+// keys of at least the given number of bits in size, such as 3072. Null
+// (unconfigured) and unknown (known after apply) values are skipped.
+func IsAuthorizedKeysLineWithMinRSABits(minRSABits int, allowedTypes ...string) isSSHPublicKeyValidator {
+	return isSSHPublicKeyValidator{
+		allowedTypes:   allowedTypes,
+		minRSABits:     minRSABits,
+		authorizedKeys: true,
+	}
+}
+
+// trimAuthorizedKeysOptions removes a leading options field from an
+// authorized_keys line. The options field is present when the line does not
+// begin with a known key type and ends at the first whitespace outside of a
+// double quoted string.
+func trimAuthorizedKeysOptions(line string) string {
+	line = strings.TrimLeft(line, " \t")
+
+	if fields := strings.Fields(line); len(fields) > 0 {
+		if _, ok := sshKeyTypes[fields[0]]; ok {
+			return line
+		}
+	}
+
+	inQuotes := false
+
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '"':
+			inQuotes = !inQuotes
+		case ' ', '\t':
+			if !inQuotes {
+				return line[i:]
+			}
+		}
+	}
+
+	return ""
+}
+
+// sshReadString reads a length-prefixed string, as defined by RFC 4251
+// Section 5, returning the string and the remaining data.
+func sshReadString(data []byte) ([]byte, []byte, bool) {
+	if len(data) < 4 {
+		return nil, nil, false
+	}
+
+	length := binary.BigEndian.Uint32(data)
+	data = data[4:]
+
+	if uint64(length) > uint64(len(data)) {
+		return nil, nil, false
+	}
+
+	return data[:length], data[length:], true
+}
+
+// sshValidateKeyData validates the key data following the embedded key type,
+// returning a reason if the data is invalid. RSA keys must be at least
+// minRSABits in size.
+func sshValidateKeyData(keyType string, data []byte, minRSABits int) string {
+	var fields [][]byte
+
+	fieldCount := 1
+
+	switch keyType {
+	case "ssh-rsa", "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521", "sk-ssh-ed25519@openssh.com":
+		fieldCount = 2
+	case "sk-ecdsa-sha2-nistp256@openssh.com":
+		fieldCount = 3
+	}
+
+	for range fieldCount {
+		field, rest, ok := sshReadString(data)
+
+		if !ok {
+			return "key data is truncated"
+		}
+
+		fields = append(fields, field)
+		data = rest
+	}
+
+	if len(data) != 0 {
+		return "key data contains unexpected trailing data"
+	}
+
+	switch keyType {
+	case "ssh-rsa":
+		// Fields are the public exponent and modulus.
+		if bits := new(big.Int).SetBytes(fields[1]).BitLen(); bits < minRSABits {
+			return fmt.Sprintf("RSA key size of %d bits, must be at least %d bits", bits, minRSABits)
+		}
+	case "ssh-ed25519", "sk-ssh-ed25519@openssh.com":
+		if len(fields[0]) != 32 {
+			return "Ed25519 key data has an invalid length"
+		}
+	default:
+		// ECDSA fields begin with the curve identifier.
+		if curve := sshKeyTypes[keyType]; string(fields[0]) != curve {
+			return fmt.Sprintf("key curve %q does not match key type %q", fields[0], keyType)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleIsSSHPublicKey() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Validate string value must be an Ed25519 or ECDSA SSH public key.
+					stringvalidator.IsSSHPublicKey("ssh-ed25519", "ecdsa-sha2-nistp256"),
+				},
+			},
+		},
+	}
+}
+
+func ExampleIsSSHPublicKey_function() {
+	_ = function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "example_param",
+				Validators: []function.StringParameterValidator{
+					// Validate string value must be an Ed25519 or ECDSA SSH public key.
+					stringvalidator.IsSSHPublicKey("ssh-ed25519", "ecdsa-sha2-nistp256"),
+				},
+			},
+		},
+	}
+}
+
+func ExampleIsSSHPublicKeyWithMinRSABits() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Validate string value must be an SSH public key, with
+					// RSA keys of at least 3072 bits.
+					stringvalidator.IsSSHPublicKeyWithMinRSABits(3072),
+				},
+			},
+		},
+	}
+}
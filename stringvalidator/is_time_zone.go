@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/timezone"
+)
+
+var _ validator.String = isTimeZoneValidator{}
+var _ function.StringParameterValidator = isTimeZoneValidator{}
+var _ validatorspec.ValidatorWithConstraint = isTimeZoneValidator{}
+
+type isTimeZoneValidator struct{}
+
+func (v isTimeZoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA Time Zone database name, such as \"America/New_York\" or \"UTC\""
+}
+
+func (v isTimeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isTimeZoneValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatTimeZone,
+	}
+}
+
+func (v isTimeZoneValidator) valid(value string) bool {
+	return timezone.IsName(value)
+}
+
+func (v isTimeZoneValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if !v.valid(value) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q", value),
+		))
+	}
+}
+
+func (v isTimeZoneValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	value := request.Value.ValueString()
+
+	if !v.valid(value) {
+		response.Error = validatorfuncerr.InvalidParameterValueMatchFuncError(
+			request.ArgumentPosition,
+			v.Description(ctx),
+			fmt.Sprintf("%q", value),
+		)
+	}
+}
+
+// IsTimeZone returns a validator which ensures that any configured attribute
+// or function parameter value is a time zone name from the IANA Time Zone
+// database, such as "Europe/Berlin". Null (unconfigured) and unknown (known
+// after apply) values are skipped.
+//
+// Names are checked against the database embedded in Go by the time/tzdata
+// package, without loading any time zone data, so validation does not depend
+// on the system running the provider. Providers which load the location with
+// time.LoadLocation on systems without time zone data, such as Windows or
+// minimal containers, should import the time/tzdata package in their main
+// package or build with the timetzdata build tag.
+func IsTimeZone() isTimeZoneValidator {
+	return isTimeZoneValidator{}
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsTimeZoneValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid region": {
+			val: types.StringValue("America/New_York"),
+		},
+		"valid UTC": {
+			val: types.StringValue("UTC"),
+		},
+		"valid Etc": {
+			val: types.StringValue("Etc/GMT+5"),
+		},
+		"invalid empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid local": {
+			val:         types.StringValue("Local"),
+			expectError: true,
+		},
+		"invalid name": {
+			val:         types.StringValue("Mars/Olympus_Mons"),
+			expectError: true,
+		},
+		"invalid system data name": {
+			val:         types.StringValue("posix/America/New_York"),
+			expectError: true,
+		},
+		"invalid offset": {
+			val:         types.StringValue("+05:00"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsTimeZone().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsTimeZone().ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
+
+func TestIsTimeZoneValidator_Diagnostic(t *testing.T) {
+	t.Parallel()
+
+	request := validator.StringRequest{
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+		ConfigValue:    types.StringValue("Mars/Olympus Mons"),
+	}
+	response := validator.StringResponse{}
+	stringvalidator.IsTimeZone().ValidateString(context.Background(), request, &response)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value Match",
+			`Attribute test value must be an IANA Time Zone database name, such as "America/New_York" or "UTC", got: "Mars/Olympus Mons"`,
+		),
+	}
+
+	if diff := cmp.Diff(expected, response.Diagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+const week = 7 * 24 * time.Hour
+
+var weeklyWindowTimeRegexp = regexp.MustCompile(`^([A-Za-z]{3}):([0-9]{2}):([0-9]{2})$`)
+
+var weeklyWindowDays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+var _ validator.String = isWeeklyWindowValidator{}
+var _ function.StringParameterValidator = isWeeklyWindowValidator{}
+var _ validatorspec.ValidatorWithConstraint = isWeeklyWindowValidator{}
+
+type isWeeklyWindowValidator struct {
+	minDuration, maxDuration time.Duration
+}
+
+func (v isWeeklyWindowValidator) invalidUsageMessage() string {
+	return fmt.Sprintf("minDuration cannot be less than zero or greater than maxDuration - minDuration: %s, maxDuration: %s", v.minDuration, v.maxDuration)
+}
+
+func (v isWeeklyWindowValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a weekly window in the format ddd:hh:mm-ddd:hh:mm with a duration between %s and %s", v.minDuration, v.maxDuration)
+}
+
+func (v isWeeklyWindowValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isWeeklyWindowValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatWeeklyWindow,
+	}
+}
+
+// parseTime returns the offset from the start of the week of a ddd:hh:mm
+// value, or a reason if the value is invalid.
+func (v isWeeklyWindowValidator) parseTime(name string, value string) (time.Duration, string) {
+	matches := weeklyWindowTimeRegexp.FindStringSubmatch(value)
+
+	if matches == nil {
+		return 0, fmt.Sprintf("%s time %q must be in the format ddd:hh:mm", name, value)
+	}
+
+	day := -1
+
+	for index, weekday := range weeklyWindowDays {
+		if strings.EqualFold(matches[1], weekday) {
+			day = index
+		}
+	}
+
+	if day < 0 {
+		return 0, fmt.Sprintf("%s day %q must be one of: Sun, Mon, Tue, Wed, Thu, Fri, Sat", name, matches[1])
+	}
+
+	// The regular expression guarantees these are numeric.
+	hour, _ := strconv.Atoi(matches[2])
+	minute, _ := strconv.Atoi(matches[3])
+
+	if hour > 23 {
+		return 0, fmt.Sprintf("%s hour %q must be between 00 and 23", name, matches[2])
+	}
+
+	if minute > 59 {
+		return 0, fmt.Sprintf("%s minute %q must be between 00 and 59", name, matches[3])
+	}
+
+	return time.Duration(day)*24*time.Hour + time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, ""
+}
+
+func (v isWeeklyWindowValidator) validate(ctx context.Context, value string) []validationFailure {
+	startValue, endValue, ok := strings.Cut(value, "-")
+
+	if !ok {
+		return []validationFailure{{description: v.Description(ctx), value: value}}
+	}
+
+	start, reason := v.parseTime("start", startValue)
+
+	if reason != "" {
+		return []validationFailure{{description: "weekly window " + reason, value: startValue}}
+	}
+
+	end, reason := v.parseTime("end", endValue)
+
+	if reason != "" {
+		return []validationFailure{{description: "weekly window " + reason, value: endValue}}
+	}
+
+	duration := end - start
+
+	// Windows may wrap around the end of the week, such as Sat:23:00-Sun:01:00.
+	if duration < 0 {
+		duration += week
+	}
+
+	if duration < v.minDuration || duration > v.maxDuration {
+		return []validationFailure{
+			{
+				description: fmt.Sprintf("weekly window duration must be between %s and %s", v.minDuration, v.maxDuration),
+				value:       duration.String(),
+			},
+		}
+	}
+
+	return nil
+}
+
+func (v isWeeklyWindowValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.minDuration < 0 || v.minDuration > v.maxDuration {
+		response.Diagnostics.Append(
+			validatordiag.InvalidValidatorUsageDiagnostic(
+				request.Path,
+				"IsWeeklyWindow",
+				v.invalidUsageMessage(),
+			),
+		)
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isWeeklyWindowValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.minDuration < 0 || v.minDuration > v.maxDuration {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			request.ArgumentPosition,
+			"IsWeeklyWindow",
+			v.invalidUsageMessage(),
+		)
+
+		return
+	}
+
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsWeeklyWindow returns a validator which ensures that any configured
+// attribute or function parameter value is a weekly time window in the format
+// ddd:hh:mm-ddd:hh:mm, such as "Mon:03:00-Mon:04:00", whose duration is
+// greater than or equal to minDuration and less than or equal to maxDuration.
+// Day names are case-insensitive and times use the 24 hour clock. Windows
+// may wrap around the end of the week, such as "Sat:23:00-Sun:01:00". Null
+// (unconfigured) and unknown (known after apply) values are skipped.
+//
+// minDuration cannot be less than zero or greater than maxDuration. Invalid
+// combinations of minDuration and maxDuration will result in an
+// implementation error message during validation.
+func IsWeeklyWindow(minDuration, maxDuration time.Duration) isWeeklyWindowValidator {
+	return isWeeklyWindowValidator{
+		minDuration: minDuration,
+		maxDuration: maxDuration,
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleIsWeeklyWindow() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Validate string value must be a weekly window between 30 minutes and 3 hours long.
+					stringvalidator.IsWeeklyWindow(30*time.Minute, 3*time.Hour),
+				},
+			},
+		},
+	}
+}
+
+func ExampleIsWeeklyWindow_function() {
+	_ = function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "example_param",
+				Validators: []function.StringParameterValidator{
+					// Validate string value must be a weekly window between 30 minutes and 3 hours long.
+					stringvalidator.IsWeeklyWindow(30*time.Minute, 3*time.Hour),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestIsWeeklyWindowValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue("Mon:03:00-Mon:04:00"),
+		},
+		"valid case-insensitive": {
+			val: types.StringValue("sun:05:30-SUN:06:30"),
+		},
+		"valid wrap around week": {
+			val: types.StringValue("Sat:23:30-Sun:00:30"),
+		},
+		"valid maximum": {
+			val: types.StringValue("Tue:00:00-Tue:03:00"),
+		},
+		"invalid too short": {
+			val:         types.StringValue("Mon:03:00-Mon:03:15"),
+			expectError: true,
+		},
+		"invalid too long": {
+			val:         types.StringValue("Mon:03:00-Tue:03:00"),
+			expectError: true,
+		},
+		"invalid missing end": {
+			val:         types.StringValue("Mon:03:00"),
+			expectError: true,
+		},
+		"invalid day": {
+			val:         types.StringValue("Foo:03:00-Foo:04:00"),
+			expectError: true,
+		},
+		"invalid hour": {
+			val:         types.StringValue("Mon:24:00-Tue:00:30"),
+			expectError: true,
+		},
+		"invalid minute": {
+			val:         types.StringValue("Mon:03:60-Mon:04:30"),
+			expectError: true,
+		},
+		"invalid format": {
+			val:         types.StringValue("Monday 03:00-04:00"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsWeeklyWindow(30*time.Minute, 3*time.Hour).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsWeeklyWindow(30*time.Minute, 3*time.Hour).ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
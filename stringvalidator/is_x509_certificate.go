@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// X509CertificateOptions are the optional constraints of the
+// IsX509Certificate validator. Zero values apply no additional constraint.
+type X509CertificateOptions struct {
+	// RequireCA ensures the certificate is a certificate authority, as
+	// indicated by its basic constraints extension.
+	RequireCA bool
+
+	// MinKeySize is the minimum size, in bits, of the certificate public key.
+	// For RSA keys this is the modulus size, for ECDSA keys this is
+	// the curve size, and Ed25519 keys are considered 256 bits.
+	MinKeySize int
+
+	// KeyAlgorithms is the list of allowed certificate public key
+	// algorithms, such as x509.RSA or x509.ECDSA.
+	KeyAlgorithms []x509.PublicKeyAlgorithm
+
+	// RejectExpired ensures the certificate has not expired, according to
+	// the Now function.
+	RejectExpired bool
+
+	// Now returns the current time used by RejectExpired. Defaults to
+	// time.Now. This is intended for testing.
+	Now func() time.Time
+}
+
+var _ validator.String = isX509CertificateValidator{}
+var _ function.StringParameterValidator = isX509CertificateValidator{}
+var _ validatorspec.ValidatorWithConstraint = isX509CertificateValidator{}
+
+type isX509CertificateValidator struct {
+	opts X509CertificateOptions
+}
+
+func (v isX509CertificateValidator) Description(_ context.Context) string {
+	return "value must be a single PEM encoded X.509 certificate"
+}
+
+func (v isX509CertificateValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v isX509CertificateValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindFormat,
+		Format: validatorspec.FormatX509Certificate,
+	}
+}
+
+func (v isX509CertificateValidator) validate(ctx context.Context, value string) []validationFailure {
+	blocks, failure := decodePEMBlocks(ctx, v, value)
+
+	if failure != nil {
+		return []validationFailure{*failure}
+	}
+
+	if len(blocks) != 1 {
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("%d PEM blocks", len(blocks)),
+			},
+		}
+	}
+
+	if blocks[0].Type != "CERTIFICATE" {
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("PEM block of type %q", blocks[0].Type),
+			},
+		}
+	}
+
+	cert, err := x509.ParseCertificate(blocks[0].Bytes)
+
+	if err != nil {
+		return []validationFailure{
+			{
+				description: v.Description(ctx),
+				value:       fmt.Sprintf("unparseable certificate: %s", err),
+			},
+		}
+	}
+
+	return v.validateCertificate(cert)
+}
+
+// validateCertificate checks the parsed certificate against the options.
+func (v isX509CertificateValidator) validateCertificate(cert *x509.Certificate) []validationFailure {
+	var failures []validationFailure
+
+	if v.opts.RequireCA && !cert.IsCA {
+		failures = append(failures, validationFailure{
+			description: "value must be a CA certificate",
+			value:       "certificate without CA basic constraint",
+		})
+	}
+
+	if len(v.opts.KeyAlgorithms) > 0 && !slices.Contains(v.opts.KeyAlgorithms, cert.PublicKeyAlgorithm) {
+		failures = append(failures, validationFailure{
+			description: fmt.Sprintf("certificate public key algorithm must be one of: %s", v.opts.KeyAlgorithms),
+			value:       cert.PublicKeyAlgorithm.String(),
+		})
+	}
+
+	if v.opts.MinKeySize > 0 {
+		if size := publicKeySize(cert.PublicKey); size < v.opts.MinKeySize {
+			failures = append(failures, validationFailure{
+				description: fmt.Sprintf("certificate public key size must be at least %d bits", v.opts.MinKeySize),
+				value:       fmt.Sprintf("%d bits", size),
+			})
+		}
+	}
+
+	if v.opts.RejectExpired {
+		now := time.Now
+
+		if v.opts.Now != nil {
+			now = v.opts.Now
+		}
+
+		if now().After(cert.NotAfter) {
+			failures = append(failures, validationFailure{
+				description: "certificate must not be expired",
+				value:       fmt.Sprintf("certificate expired at %s", cert.NotAfter.UTC().Format(time.RFC3339)),
+			})
+		}
+	}
+
+	return failures
+}
+
+func (v isX509CertificateValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v isX509CertificateValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// IsX509Certificate returns a validator which ensures that any configured
+// attribute or function parameter value is a single PEM encoded X.509
+// certificate which satisfies the given options. Null (unconfigured) and
+// unknown (known after apply) values are skipped.
+//
+// Use IsCertificateChain for values containing multiple certificates.
+func IsX509Certificate(opts X509CertificateOptions) isX509CertificateValidator {
+	return isX509CertificateValidator{
+		opts: opts,
+	}
+}
+
+// publicKeySize returns the size, in bits, of the given public key or zero
+// if the key type is not supported.
+func publicKeySize(publicKey any) int {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return key.N.BitLen()
+	case *ecdsa.PublicKey:
+		return key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return ed25519.PublicKeySize * 8
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"crypto/x509"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleIsX509Certificate() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Validate string value must be an unexpired RSA or ECDSA CA certificate.
+					stringvalidator.IsX509Certificate(stringvalidator.X509CertificateOptions{
+						RequireCA:     true,
+						KeyAlgorithms: []x509.PublicKeyAlgorithm{x509.RSA, x509.ECDSA},
+						RejectExpired: true,
+					}),
+				},
+			},
+		},
+	}
+}
+
+func ExampleIsX509Certificate_function() {
+	_ = function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "example_param",
+				Validators: []function.StringParameterValidator{
+					// Validate string value must be an unexpired RSA or ECDSA CA certificate.
+					stringvalidator.IsX509Certificate(stringvalidator.X509CertificateOptions{
+						RequireCA:     true,
+						KeyAlgorithms: []x509.PublicKeyAlgorithm{x509.RSA, x509.ECDSA},
+						RejectExpired: true,
+					}),
+				},
+			},
+		},
+	}
+}
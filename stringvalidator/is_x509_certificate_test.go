@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+var (
+	testCertNotBefore = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCertNotAfter  = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// testCertificate creates a PEM encoded certificate for the given key, signed
+// by the parent certificate and key or self-signed if parent is nil.
+func testCertificate(t *testing.T, key crypto.Signer, isCA bool, parent *x509.Certificate, parentKey crypto.Signer) (string, *x509.Certificate) {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "test"},
+		NotBefore:             testCertNotBefore,
+		NotAfter:              testCertNotAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+
+	if isCA {
+		template.KeyUsage = x509.KeyUsageCertSign
+	}
+
+	if parent == nil {
+		parent = template
+		parentKey = key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+
+	if err != nil {
+		t.Fatalf("unable to create certificate: %s", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), cert
+}
+
+func testECDSAKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+
+	return key
+}
+
+func TestIsX509CertificateValidator(t *testing.T) {
+	t.Parallel()
+
+	ecdsaKey := testECDSAKey(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+
+	caPEM, caCert := testCertificate(t, ecdsaKey, true, nil, nil)
+	leafPEM, _ := testCertificate(t, rsaKey, false, caCert, ecdsaKey)
+	ed25519PEM, _ := testCertificate(t, ed25519Key, false, nil, nil)
+
+	beforeExpiry := func() time.Time { return testCertNotAfter.Add(-time.Hour) }
+	afterExpiry := func() time.Time { return testCertNotAfter.Add(time.Hour) }
+
+	type testCase struct {
+		val         types.String
+		opts        stringvalidator.X509CertificateOptions
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue(leafPEM),
+		},
+		"valid ed25519": {
+			val: types.StringValue(ed25519PEM),
+		},
+		"invalid not PEM": {
+			val:         types.StringValue("not a certificate"),
+			expectError: true,
+		},
+		"invalid truncated leading certificate": {
+			val:         types.StringValue(caPEM[:len(caPEM)/2] + "\n" + leafPEM),
+			expectError: true,
+		},
+		"invalid multiple certificates": {
+			val:         types.StringValue(leafPEM + caPEM),
+			expectError: true,
+		},
+		"invalid block type": {
+			val:         types.StringValue(strings.ReplaceAll(leafPEM, "CERTIFICATE", "PUBLIC KEY")),
+			expectError: true,
+		},
+		"invalid certificate data": {
+			val:         types.StringValue("-----BEGIN CERTIFICATE-----\ndGVzdA==\n-----END CERTIFICATE-----\n"),
+			expectError: true,
+		},
+		"CA required valid": {
+			val:  types.StringValue(caPEM),
+			opts: stringvalidator.X509CertificateOptions{RequireCA: true},
+		},
+		"CA required invalid": {
+			val:         types.StringValue(leafPEM),
+			opts:        stringvalidator.X509CertificateOptions{RequireCA: true},
+			expectError: true,
+		},
+		"min key size valid": {
+			val:  types.StringValue(caPEM),
+			opts: stringvalidator.X509CertificateOptions{MinKeySize: 256},
+		},
+		"min key size invalid": {
+			val:         types.StringValue(leafPEM),
+			opts:        stringvalidator.X509CertificateOptions{MinKeySize: 2048},
+			expectError: true,
+		},
+		"key algorithm valid": {
+			val:  types.StringValue(caPEM),
+			opts: stringvalidator.X509CertificateOptions{KeyAlgorithms: []x509.PublicKeyAlgorithm{x509.ECDSA, x509.Ed25519}},
+		},
+		"key algorithm invalid": {
+			val:         types.StringValue(leafPEM),
+			opts:        stringvalidator.X509CertificateOptions{KeyAlgorithms: []x509.PublicKeyAlgorithm{x509.ECDSA, x509.Ed25519}},
+			expectError: true,
+		},
+		"not expired": {
+			val:  types.StringValue(leafPEM),
+			opts: stringvalidator.X509CertificateOptions{RejectExpired: true, Now: beforeExpiry},
+		},
+		"expired": {
+			val:         types.StringValue(leafPEM),
+			opts:        stringvalidator.X509CertificateOptions{RejectExpired: true, Now: afterExpiry},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.IsX509Certificate(test.opts).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.IsX509Certificate(test.opts).ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
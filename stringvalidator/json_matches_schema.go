@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/jsonschema"
+)
+
+var _ validator.String = jsonMatchesSchemaValidator{}
+var _ function.StringParameterValidator = jsonMatchesSchemaValidator{}
+var _ validatorspec.ValidatorWithConstraint = jsonMatchesSchemaValidator{}
+
+type jsonMatchesSchemaValidator struct {
+	schema *jsonschema.Schema
+
+	// schemaJSON is the JSON Schema document the schema was compiled from.
+	schemaJSON string
+
+	// schemaErr is the error from compiling the schema, which is reported
+	// as an invalid usage during validation.
+	schemaErr error
+}
+
+func (v jsonMatchesSchemaValidator) invalidUsageMessage() string {
+	return fmt.Sprintf("schemaJSON must be a valid JSON Schema using only supported keywords: %s", v.schemaErr)
+}
+
+func (v jsonMatchesSchemaValidator) Description(_ context.Context) string {
+	return "value must be a JSON document matching the JSON Schema"
+}
+
+func (v jsonMatchesSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v jsonMatchesSchemaValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindJSONSchema,
+		Schema: v.schemaJSON,
+	}
+}
+
+func (v jsonMatchesSchemaValidator) validate(_ context.Context, value string) []validationFailure {
+	document, err := jsonschema.Decode([]byte(value))
+
+	if err != nil {
+		return []validationFailure{
+			{
+				description: "value must be valid JSON",
+				value:       err.Error(),
+			},
+		}
+	}
+
+	violations := v.schema.Validate(document)
+	failures := make([]validationFailure, 0, len(violations))
+
+	for _, violation := range violations {
+		failures = append(failures, validationFailure{
+			description: fmt.Sprintf("value at JSON Pointer %q %s", violation.Pointer, violation.Description),
+			value:       violation.Value,
+		})
+	}
+
+	return failures
+}
+
+func (v jsonMatchesSchemaValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.schemaErr != nil {
+		response.Diagnostics.Append(
+			validatordiag.InvalidValidatorUsageDiagnostic(
+				request.Path,
+				"JSONMatchesSchema",
+				v.invalidUsageMessage(),
+			),
+		)
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.ConfigValue.ValueString())
+
+	response.Diagnostics.Append(validationFailureDiagnostics(request.Path, failures)...)
+}
+
+func (v jsonMatchesSchemaValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if v.schemaErr != nil {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(
+			request.ArgumentPosition,
+			"JSONMatchesSchema",
+			v.invalidUsageMessage(),
+		)
+
+		return
+	}
+
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	failures := v.validate(ctx, request.Value.ValueString())
+
+	response.Error = validationFailureFuncError(request.ArgumentPosition, failures)
+}
+
+// JSONMatchesSchema returns a validator which ensures that any configured
+// attribute or function parameter value is a JSON document which satisfies
+// the given JSON Schema. Null (unconfigured) and unknown (known after apply)
+// values are skipped.
+//
+// A subset of JSON Schema draft 2020-12 is supported:
+//
+//   - type, enum, and const
+//   - properties, required, and additionalProperties
+//   - items, minItems, and maxItems
+//   - minLength, maxLength, and pattern, where pattern uses RE2 syntax
+//   - minimum, maximum, exclusiveMinimum, and exclusiveMaximum
+//   - $ref, limited to JSON Pointers within the schema, such as "#/$defs/name"
+//
+// Annotation keywords, such as title and description, are ignored. A schema
+// that is not valid JSON or uses any other keyword will result in an
+// implementation error message during validation.
+//
+// Every violation is returned as its own diagnostic, which includes the JSON
+// Pointer (RFC 6901) to the failing value. The root value has an empty
+// pointer.
+func JSONMatchesSchema(schemaJSON string) jsonMatchesSchemaValidator {
+	schema, err := jsonschema.Compile([]byte(schemaJSON))
+
+	return jsonMatchesSchemaValidator{
+		schema:     schema,
+		schemaJSON: schemaJSON,
+		schemaErr:  err,
+	}
+}
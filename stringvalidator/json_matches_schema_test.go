@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestJSONMatchesSchemaValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		schema      string
+		expectError bool
+	}
+
+	const schema = `{
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string"},
+			"replicas": {"type": "integer", "minimum": 1}
+		}
+	}`
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:    types.StringUnknown(),
+			schema: schema,
+		},
+		"null String": {
+			val:    types.StringNull(),
+			schema: schema,
+		},
+		"valid": {
+			val:    types.StringValue(`{"name": "example", "replicas": 3}`),
+			schema: schema,
+		},
+		"invalid JSON": {
+			val:         types.StringValue(`{"name": `),
+			expectError: true,
+			schema:      schema,
+		},
+		"invalid JSON trailing data": {
+			val:         types.StringValue(`{"name": "example"} xyz`),
+			expectError: true,
+			schema:      schema,
+		},
+		"invalid JSON trailing closing brace": {
+			val:         types.StringValue(`{"name": "example"} }`),
+			expectError: true,
+			schema:      schema,
+		},
+		"invalid type": {
+			val:         types.StringValue(`{"name": 1}`),
+			expectError: true,
+			schema:      schema,
+		},
+		"invalid missing required": {
+			val:         types.StringValue(`{"replicas": 3}`),
+			expectError: true,
+			schema:      schema,
+		},
+		"invalid schema": {
+			val:         types.StringValue(`{"name": "example"}`),
+			schema:      `{"anyOf": []}`,
+			expectError: true,
+		},
+		"invalid schema self ref": {
+			val:         types.StringValue(`{"name": "example"}`),
+			schema:      `{"$ref": "#"}`,
+			expectError: true,
+		},
+		"invalid schema cyclic ref": {
+			val:         types.StringValue(`{"name": "example"}`),
+			schema:      `{"$defs": {"a": {"$ref": "#/$defs/a"}}, "$ref": "#/$defs/a"}`,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.JSONMatchesSchema(test.schema).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.JSONMatchesSchema(test.schema).ValidateParameterString(context.TODO(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
+
+func TestJSONMatchesSchemaValidator_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	request := validator.StringRequest{
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+		ConfigValue:    types.StringValue(`{"panels": [{"title": ""}, {"title": "ok", "width": 30}]}`),
+	}
+	response := validator.StringResponse{}
+
+	stringvalidator.JSONMatchesSchema(`{
+		"properties": {
+			"panels": {
+				"items": {
+					"properties": {
+						"title": {"type": "string", "minLength": 1},
+						"width": {"type": "integer", "maximum": 24}
+					}
+				}
+			}
+		}
+	}`).ValidateString(context.Background(), request, &response)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value",
+			`Attribute test value at JSON Pointer "/panels/0/title" string length must be at least 1, got: 0`,
+		),
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value",
+			`Attribute test value at JSON Pointer "/panels/1/width" must be less than or equal to 24, got: 30`,
+		),
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
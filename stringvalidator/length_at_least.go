@@ -6,16 +6,20 @@ package stringvalidator
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.String = lengthAtLeastValidator{}
 var _ function.StringParameterValidator = lengthAtLeastValidator{}
+var _ validatorspec.ValidatorWithConstraint = lengthAtLeastValidator{}
 
 type lengthAtLeastValidator struct {
 	minLength int
@@ -30,7 +34,14 @@ func (validator lengthAtLeastValidator) Description(_ context.Context) string {
 }
 
 func (validator lengthAtLeastValidator) MarkdownDescription(ctx context.Context) string {
-	return validator.Description(ctx)
+	return validatormarkdown.Describe(ctx, validator)
+}
+
+func (validator lengthAtLeastValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindLength,
+		Min:  new(big.Float).SetInt64(int64(validator.minLength)),
+	}
 }
 
 func (v lengthAtLeastValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
@@ -6,15 +6,19 @@ package stringvalidator
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var _ validator.String = lengthAtMostValidator{}
 var _ function.StringParameterValidator = lengthAtMostValidator{}
+var _ validatorspec.ValidatorWithConstraint = lengthAtMostValidator{}
 
 type lengthAtMostValidator struct {
 	maxLength int
@@ -29,7 +33,14 @@ func (validator lengthAtMostValidator) Description(_ context.Context) string {
 }
 
 func (validator lengthAtMostValidator) MarkdownDescription(ctx context.Context) string {
-	return validator.Description(ctx)
+	return validatormarkdown.Describe(ctx, validator)
+}
+
+func (validator lengthAtMostValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindLength,
+		Max:  new(big.Float).SetInt64(int64(validator.maxLength)),
+	}
 }
 
 func (v lengthAtMostValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
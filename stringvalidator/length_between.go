@@ -6,15 +6,19 @@ package stringvalidator
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var _ validator.String = lengthBetweenValidator{}
 var _ function.StringParameterValidator = lengthBetweenValidator{}
+var _ validatorspec.ValidatorWithConstraint = lengthBetweenValidator{}
 
 type lengthBetweenValidator struct {
 	minLength, maxLength int
@@ -29,7 +33,15 @@ func (validator lengthBetweenValidator) Description(_ context.Context) string {
 }
 
 func (validator lengthBetweenValidator) MarkdownDescription(ctx context.Context) string {
-	return validator.Description(ctx)
+	return validatormarkdown.Describe(ctx, validator)
+}
+
+func (validator lengthBetweenValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindLength,
+		Min:  new(big.Float).SetInt64(int64(validator.minLength)),
+		Max:  new(big.Float).SetInt64(int64(validator.maxLength)),
+	}
 }
 
 func (v lengthBetweenValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
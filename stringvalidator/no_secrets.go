@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/secretscan"
+)
+
+// NoSecretsOptions are the options of the NoSecretsWithOptions validator.
+// Zero values use the defaults.
+type NoSecretsOptions struct {
+	// EntropyThreshold is the Shannon entropy, in bits per character, from
+	// which tokens of at least 20 characters, such as base64 encoded keys,
+	// are reported. The threshold is scaled down for tokens shorter than 32
+	// characters, whose entropy cannot reach it. Defaults to 4.5. A negative
+	// value disables the check.
+	EntropyThreshold float64
+
+	// Severity is the severity of diagnostics, either diag.SeverityWarning
+	// or diag.SeverityError. Defaults to diag.SeverityWarning.
+	Severity diag.Severity
+}
+
+var _ validator.String = noSecretsValidator{}
+var _ validatorspec.ValidatorWithConstraint = noSecretsValidator{}
+
+type noSecretsValidator struct {
+	opts secretscan.Options
+}
+
+func (v noSecretsValidator) Description(_ context.Context) string {
+	return secretscan.Description
+}
+
+func (v noSecretsValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v noSecretsValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindNoSecrets,
+	}
+}
+
+func (v noSecretsValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if err := v.opts.Validate(); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidValidatorUsageDiagnostic(request.Path, "NoSecretsWithOptions", err.Error()))
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(secretscan.Diagnostics(request.Path, request.ConfigValue.ValueString(), v.opts)...)
+}
+
+// NoSecrets returns a validator which warns when any configured attribute
+// value resembles a credential, such as a cloud access key, a JSON Web
+// Token, a PEM private key, or a high-entropy token. Null (unconfigured) and
+// unknown (known after apply) values are skipped.
+//
+// This is intended for attributes which are not sensitive, such as
+// descriptions or tags, where credentials would be shown in plan output and
+// stored in state. Diagnostics only include a masked fragment of the
+// matching part of the value.
+func NoSecrets() noSecretsValidator {
+	return NoSecretsWithOptions(NoSecretsOptions{})
+}
+
+// NoSecretsWithOptions returns a NoSecrets validator with the given entropy
+// threshold and diagnostic severity.
+func NoSecretsWithOptions(opts NoSecretsOptions) noSecretsValidator {
+	return noSecretsValidator{
+		opts: secretscan.Options{
+			EntropyThreshold: opts.EntropyThreshold,
+			Severity:         opts.Severity,
+		},
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func ExampleNoSecretsWithOptions() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					// Raise an error if the description resembles a credential,
+					// reporting fewer high-entropy tokens than the default.
+					stringvalidator.NoSecretsWithOptions(stringvalidator.NoSecretsOptions{
+						EntropyThreshold: 5,
+						Severity:         diag.SeverityError,
+					}),
+				},
+			},
+		},
+	}
+}
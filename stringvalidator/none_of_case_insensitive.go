@@ -14,21 +14,32 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.String = noneOfCaseInsensitiveValidator{}
 var _ function.StringParameterValidator = noneOfCaseInsensitiveValidator{}
+var _ validatorspec.ValidatorWithConstraint = noneOfCaseInsensitiveValidator{}
 
 type noneOfCaseInsensitiveValidator struct {
 	values []types.String
 }
 
-func (v noneOfCaseInsensitiveValidator) Description(ctx context.Context) string {
-	return v.MarkdownDescription(ctx)
+func (v noneOfCaseInsensitiveValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: %s", v.values)
 }
 
-func (v noneOfCaseInsensitiveValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("value must be none of: %s", v.values)
+func (v noneOfCaseInsensitiveValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v noneOfCaseInsensitiveValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:            validatorspec.KindNoneOf,
+		Values:          validatorspec.Values(v.values),
+		CaseInsensitive: true,
+	}
 }
 
 func (v noneOfCaseInsensitiveValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
@@ -112,14 +112,16 @@ func TestNoneOfCaseInsensitiveValidator_Description(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		in       []string
-		expected string
+		in               []string
+		expected         string
+		expectedMarkdown string
 	}
 
 	testCases := map[string]testCase{
 		"quoted-once": {
-			in:       []string{"foo", "bar", "baz"},
-			expected: `value must be none of: ["foo" "bar" "baz"]`,
+			in:               []string{"foo", "bar", "baz"},
+			expected:         `value must be none of: ["foo" "bar" "baz"]`,
+			expectedMarkdown: "value must be none of, ignoring case:\n- `\"foo\"`\n- `\"bar\"`\n- `\"baz\"`",
 		},
 	}
 
@@ -130,11 +132,17 @@ func TestNoneOfCaseInsensitiveValidator_Description(t *testing.T) {
 
 			v := stringvalidator.NoneOfCaseInsensitive(test.in...)
 
-			got := v.MarkdownDescription(context.Background())
+			got := v.Description(context.Background())
 
 			if diff := cmp.Diff(got, test.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
+
+			gotMarkdown := v.MarkdownDescription(context.Background())
+
+			if diff := cmp.Diff(gotMarkdown, test.expectedMarkdown); diff != "" {
+				t.Errorf("unexpected Markdown difference: %s", diff)
+			}
 		})
 	}
 }
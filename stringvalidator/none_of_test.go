@@ -111,14 +111,16 @@ func TestNoneOfValidator_Description(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		in       []string
-		expected string
+		in               []string
+		expected         string
+		expectedMarkdown string
 	}
 
 	testCases := map[string]testCase{
 		"quoted-once": {
-			in:       []string{"foo", "bar", "baz"},
-			expected: `value must be none of: ["foo" "bar" "baz"]`,
+			in:               []string{"foo", "bar", "baz"},
+			expected:         `value must be none of: ["foo" "bar" "baz"]`,
+			expectedMarkdown: "value must be none of:\n- `\"foo\"`\n- `\"bar\"`\n- `\"baz\"`",
 		},
 	}
 
@@ -129,11 +131,17 @@ func TestNoneOfValidator_Description(t *testing.T) {
 
 			v := stringvalidator.NoneOf(test.in...)
 
-			got := v.MarkdownDescription(context.Background())
+			got := v.Description(context.Background())
 
 			if diff := cmp.Diff(got, test.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
+
+			gotMarkdown := v.MarkdownDescription(context.Background())
+
+			if diff := cmp.Diff(gotMarkdown, test.expectedMarkdown); diff != "" {
+				t.Errorf("unexpected Markdown difference: %s", diff)
+			}
 		})
 	}
 }
@@ -13,40 +13,83 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.String = oneOfValidator{}
 var _ function.StringParameterValidator = oneOfValidator{}
+var _ validatorspec.ValidatorWithConstraint = oneOfValidator{}
+
+// oneOfLookupThreshold is the number of values at or above which the OneOf
+// and OneOfCaseInsensitive validators use a set, rather than a linear scan,
+// to check values.
+const oneOfLookupThreshold = 16
 
 type oneOfValidator struct {
 	values []types.String
-}
 
-func (v oneOfValidator) Description(ctx context.Context) string {
-	return v.MarkdownDescription(ctx)
+	// lookup contains the values when there are at least
+	// oneOfLookupThreshold of them.
+	lookup map[string]struct{}
 }
 
-func (v oneOfValidator) MarkdownDescription(_ context.Context) string {
+func (v oneOfValidator) Description(_ context.Context) string {
 	return fmt.Sprintf("value must be one of: %s", v.values)
 }
 
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v oneOfValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:   validatorspec.KindOneOf,
+		Values: validatorspec.Values(v.values),
+	}
+}
+
+func (v oneOfValidator) contains(value string) bool {
+	if v.lookup != nil {
+		_, ok := v.lookup[value]
+
+		return ok
+	}
+
+	for _, otherValue := range v.values {
+		if value == otherValue.ValueString() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v oneOfValidator) candidates() []string {
+	candidates := make([]string, 0, len(v.values))
+
+	for _, value := range v.values {
+		candidates = append(candidates, value.ValueString())
+	}
+
+	return candidates
+}
+
 func (v oneOfValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
 	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
 		return
 	}
 
-	value := request.ConfigValue
+	value := request.ConfigValue.ValueString()
 
-	for _, otherValue := range v.values {
-		if value.Equal(otherValue) {
-			return
-		}
+	if v.contains(value) {
+		return
 	}
 
 	response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
 		request.Path,
 		v.Description(ctx),
-		value.String(),
+		suggestionValue(value, v.candidates()),
 	))
 }
 
@@ -55,23 +98,22 @@ func (v oneOfValidator) ValidateParameterString(ctx context.Context, request fun
 		return
 	}
 
-	value := request.Value
+	value := request.Value.ValueString()
 
-	for _, otherValue := range v.values {
-		if value.Equal(otherValue) {
-			return
-		}
+	if v.contains(value) {
+		return
 	}
 
 	response.Error = validatorfuncerr.InvalidParameterValueMatchFuncError(
 		request.ArgumentPosition,
 		v.Description(ctx),
-		value.String(),
+		suggestionValue(value, v.candidates()),
 	)
 }
 
 // OneOf checks that the String held in the attribute or function parameter
-// is one of the given `values`.
+// is one of the given `values`. When the value does not match, the error
+// suggests the closest of the `values` by edit distance, if one is similar.
 func OneOf(values ...string) oneOfValidator {
 	frameworkValues := make([]types.String, 0, len(values))
 
@@ -79,7 +121,30 @@ func OneOf(values ...string) oneOfValidator {
 		frameworkValues = append(frameworkValues, types.StringValue(value))
 	}
 
-	return oneOfValidator{
+	v := oneOfValidator{
 		values: frameworkValues,
 	}
+
+	if len(values) >= oneOfLookupThreshold {
+		v.lookup = make(map[string]struct{}, len(values))
+
+		for _, value := range values {
+			v.lookup[value] = struct{}{}
+		}
+	}
+
+	return v
+}
+
+// OneOfEnum checks that the String held in the attribute or function
+// parameter is one of the given `values`, which can be typed string
+// constants. It is otherwise equivalent to OneOf.
+func OneOfEnum[T ~string](values ...T) oneOfValidator {
+	stringValues := make([]string, 0, len(values))
+
+	for _, value := range values {
+		stringValues = append(stringValues, string(value))
+	}
+
+	return OneOf(stringValues...)
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -14,40 +15,79 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.String = oneOfCaseInsensitiveValidator{}
 var _ function.StringParameterValidator = oneOfCaseInsensitiveValidator{}
+var _ validatorspec.ValidatorWithConstraint = oneOfCaseInsensitiveValidator{}
 
 type oneOfCaseInsensitiveValidator struct {
 	values []types.String
-}
 
-func (v oneOfCaseInsensitiveValidator) Description(ctx context.Context) string {
-	return v.MarkdownDescription(ctx)
+	// lookup contains the folded values when there are at least
+	// oneOfLookupThreshold of them.
+	lookup map[string]struct{}
 }
 
-func (v oneOfCaseInsensitiveValidator) MarkdownDescription(_ context.Context) string {
+func (v oneOfCaseInsensitiveValidator) Description(_ context.Context) string {
 	return fmt.Sprintf("value must be one of: %s", v.values)
 }
 
+func (v oneOfCaseInsensitiveValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v oneOfCaseInsensitiveValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind:            validatorspec.KindOneOf,
+		Values:          validatorspec.Values(v.values),
+		CaseInsensitive: true,
+	}
+}
+
+func (v oneOfCaseInsensitiveValidator) contains(value string) bool {
+	if v.lookup != nil {
+		_, ok := v.lookup[foldCase(value)]
+
+		return ok
+	}
+
+	for _, otherValue := range v.values {
+		if strings.EqualFold(value, otherValue.ValueString()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v oneOfCaseInsensitiveValidator) candidates() []string {
+	candidates := make([]string, 0, len(v.values))
+
+	for _, value := range v.values {
+		candidates = append(candidates, value.ValueString())
+	}
+
+	return candidates
+}
+
 func (v oneOfCaseInsensitiveValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
 	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
 		return
 	}
 
-	value := request.ConfigValue
+	value := request.ConfigValue.ValueString()
 
-	for _, otherValue := range v.values {
-		if strings.EqualFold(value.ValueString(), otherValue.ValueString()) {
-			return
-		}
+	if v.contains(value) {
+		return
 	}
 
 	response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
 		request.Path,
 		v.Description(ctx),
-		value.String(),
+		suggestionValue(value, v.candidates()),
 	))
 }
 
@@ -56,23 +96,22 @@ func (v oneOfCaseInsensitiveValidator) ValidateParameterString(ctx context.Conte
 		return
 	}
 
-	value := request.Value
+	value := request.Value.ValueString()
 
-	for _, otherValue := range v.values {
-		if strings.EqualFold(value.ValueString(), otherValue.ValueString()) {
-			return
-		}
+	if v.contains(value) {
+		return
 	}
 
 	response.Error = validatorfuncerr.InvalidParameterValueMatchFuncError(
 		request.ArgumentPosition,
 		v.Description(ctx),
-		value.String(),
+		suggestionValue(value, v.candidates()),
 	)
 }
 
 // OneOfCaseInsensitive checks that the String held in the attribute or function parameter
-// is one of the given `values`.
+// is one of the given `values`. When the value does not match, the error
+// suggests the closest of the `values` by edit distance, if one is similar.
 func OneOfCaseInsensitive(values ...string) oneOfCaseInsensitiveValidator {
 	frameworkValues := make([]types.String, 0, len(values))
 
@@ -80,7 +119,44 @@ func OneOfCaseInsensitive(values ...string) oneOfCaseInsensitiveValidator {
 		frameworkValues = append(frameworkValues, types.StringValue(value))
 	}
 
-	return oneOfCaseInsensitiveValidator{
+	v := oneOfCaseInsensitiveValidator{
 		values: frameworkValues,
 	}
+
+	if len(values) >= oneOfLookupThreshold {
+		v.lookup = make(map[string]struct{}, len(values))
+
+		for _, value := range values {
+			v.lookup[foldCase(value)] = struct{}{}
+		}
+	}
+
+	return v
+}
+
+// foldCase returns a case folded form of the value for use as a lookup key.
+// Keys are equal exactly when strings.EqualFold reports the values as equal,
+// so the lookup accepts the same values as comparing each value.
+func foldCase(value string) string {
+	var b strings.Builder
+
+	b.Grow(len(value))
+
+	for _, r := range value {
+		b.WriteRune(foldRune(r))
+	}
+
+	return b.String()
+}
+
+// foldRune returns the smallest rune of the simple case folding orbit of the
+// rune, which strings.EqualFold considers equal to each other rune of it.
+func foldRune(r rune) rune {
+	smallest := r
+
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		smallest = min(smallest, f)
+	}
+
+	return smallest
 }
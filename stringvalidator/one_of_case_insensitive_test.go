@@ -26,6 +26,15 @@ func TestOneOfCaseInsensitiveValidator(t *testing.T) {
 	}
 
 	testCases := map[string]testCase{
+		"large-match-case-insensitive": {
+			in:          types.StringValue("VALUE-099"),
+			oneOfValues: testOneOfValues(100),
+		},
+		"large-mismatch": {
+			in:          types.StringValue("value-100"),
+			oneOfValues: testOneOfValues(100),
+			expectError: true,
+		},
 		"simple-match": {
 			in: types.StringValue("foo"),
 			oneOfValues: []string{
@@ -107,18 +116,92 @@ func TestOneOfCaseInsensitiveValidator(t *testing.T) {
 	}
 }
 
+func TestOneOfCaseInsensitiveValidator_Folding(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		in          string
+		value       string
+		expectError bool
+	}
+
+	// Values with case folds which differ between strings.EqualFold and
+	// converting to upper and then lower case.
+	testCases := map[string]testCase{
+		"greek-iota-dialytika-tonos": {
+			in:    "\u0390",
+			value: "\u1fd3",
+		},
+		"greek-upsilon-dialytika-tonos": {
+			in:    "\u03b0",
+			value: "\u1fe3",
+		},
+		"long-s-t-ligature": {
+			in:    "\ufb05",
+			value: "\ufb06",
+		},
+		"kelvin-sign": {
+			in:    "\u212a",
+			value: "k",
+		},
+		"long-s": {
+			in:    "\u017f",
+			value: "S",
+		},
+		"dotted-capital-i": {
+			in:          "\u0130",
+			value:       "i",
+			expectError: true,
+		},
+		"dotless-small-i": {
+			in:          "\u0131",
+			value:       "I",
+			expectError: true,
+		},
+	}
+
+	for name, test := range testCases {
+
+		// The lookup is only used with at least 16 values, which must accept
+		// the same values as comparing each value.
+		for size, oneOfValues := range map[string][]string{
+			"simple": {test.value},
+			"large":  append(testOneOfValues(20), test.value),
+		} {
+			t.Run(fmt.Sprintf("%s - %s", size, name), func(t *testing.T) {
+				t.Parallel()
+				req := validator.StringRequest{
+					ConfigValue: types.StringValue(test.in),
+				}
+				res := validator.StringResponse{}
+				stringvalidator.OneOfCaseInsensitive(oneOfValues...).ValidateString(context.TODO(), req, &res)
+
+				if !res.Diagnostics.HasError() && test.expectError {
+					t.Fatal("expected error, got no error")
+				}
+
+				if res.Diagnostics.HasError() && !test.expectError {
+					t.Fatalf("got unexpected error: %s", res.Diagnostics)
+				}
+			})
+		}
+	}
+}
+
 func TestOneOfCaseInsensitiveValidator_Description(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		in       []string
-		expected string
+		in               []string
+		expected         string
+		expectedMarkdown string
 	}
 
 	testCases := map[string]testCase{
 		"quoted-once": {
-			in:       []string{"foo", "bar", "baz"},
-			expected: `value must be one of: ["foo" "bar" "baz"]`,
+			in:               []string{"foo", "bar", "baz"},
+			expected:         `value must be one of: ["foo" "bar" "baz"]`,
+			expectedMarkdown: "value must be one of, ignoring case:\n- `\"foo\"`\n- `\"bar\"`\n- `\"baz\"`",
 		},
 	}
 
@@ -129,11 +212,17 @@ func TestOneOfCaseInsensitiveValidator_Description(t *testing.T) {
 
 			v := stringvalidator.OneOfCaseInsensitive(test.in...)
 
-			got := v.MarkdownDescription(context.Background())
+			got := v.Description(context.Background())
 
 			if diff := cmp.Diff(got, test.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
+
+			gotMarkdown := v.MarkdownDescription(context.Background())
+
+			if diff := cmp.Diff(gotMarkdown, test.expectedMarkdown); diff != "" {
+				t.Errorf("unexpected Markdown difference: %s", diff)
+			}
 		})
 	}
 }
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestOneOfFuncValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		in          types.String
+		source      func(context.Context) ([]string, error)
+		expectError bool
+	}
+
+	values := func(_ context.Context) ([]string, error) {
+		return []string{"one", "two", "three"}, nil
+	}
+
+	testCases := map[string]testCase{
+		"simple-match": {
+			in:     types.StringValue("two"),
+			source: values,
+		},
+		"simple-mismatch": {
+			in:          types.StringValue("four"),
+			source:      values,
+			expectError: true,
+		},
+		"source-error": {
+			in: types.StringValue("two"),
+			source: func(_ context.Context) ([]string, error) {
+				return nil, errors.New("catalogue unavailable")
+			},
+			expectError: true,
+		},
+		"nil-source": {
+			in:          types.StringValue("two"),
+			expectError: true,
+		},
+		"skip-validation-on-null": {
+			in:     types.StringNull(),
+			source: values,
+		},
+		"skip-validation-on-unknown": {
+			in:     types.StringUnknown(),
+			source: values,
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(fmt.Sprintf("ValidateString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{
+				ConfigValue: test.in,
+			}
+			res := validator.StringResponse{}
+			stringvalidator.OneOfFunc(test.source, stringvalidator.OneOfFuncOptions{}).ValidateString(context.TODO(), req, &res)
+
+			if !res.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if res.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", res.Diagnostics)
+			}
+		})
+
+		t.Run(fmt.Sprintf("ValidateParameterString - %s", name), func(t *testing.T) {
+			t.Parallel()
+			req := function.StringParameterValidatorRequest{
+				Value: test.in,
+			}
+			res := function.StringParameterValidatorResponse{}
+			stringvalidator.OneOfFunc(test.source, stringvalidator.OneOfFuncOptions{}).ValidateParameterString(context.TODO(), req, &res)
+
+			if res.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if res.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", res.Error)
+			}
+		})
+	}
+}
+
+func TestOneOfFuncValidator_Memoize(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		options       stringvalidator.OneOfFuncOptions
+		expectedCalls int
+	}
+
+	testCases := map[string]testCase{
+		"disabled": {
+			options:       stringvalidator.OneOfFuncOptions{},
+			expectedCalls: 3,
+		},
+		"enabled": {
+			options: stringvalidator.OneOfFuncOptions{
+				Memoize: true,
+			},
+			expectedCalls: 1,
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			v := stringvalidator.OneOfFunc(func(_ context.Context) ([]string, error) {
+				calls++
+
+				return []string{"one", "two", "three"}, nil
+			}, test.options)
+
+			for range 3 {
+				req := validator.StringRequest{
+					ConfigValue: types.StringValue("two"),
+				}
+				res := validator.StringResponse{}
+				v.ValidateString(context.TODO(), req, &res)
+
+				if res.Diagnostics.HasError() {
+					t.Fatalf("got unexpected error: %s", res.Diagnostics)
+				}
+			}
+
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d source calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestOneOfFuncValidator_SourceError(t *testing.T) {
+	t.Parallel()
+
+	req := validator.StringRequest{
+		Path:        path.Root("test"),
+		ConfigValue: types.StringValue("two"),
+	}
+	res := validator.StringResponse{}
+	stringvalidator.OneOfFunc(func(_ context.Context) ([]string, error) {
+		return nil, errors.New("catalogue unavailable")
+	}, stringvalidator.OneOfFuncOptions{}).ValidateString(context.TODO(), req, &res)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Unable to Determine Allowed Values",
+			"When validating attribute test, the allowed values could not be determined. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				`The value source of the "OneOfFunc" validator returned an error: catalogue unavailable`,
+		),
+	}
+
+	if diff := cmp.Diff(res.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestOneOfFuncValidator_Description(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	v := stringvalidator.OneOfFunc(func(_ context.Context) ([]string, error) {
+		calls++
+
+		return nil, errors.New("catalogue unavailable")
+	}, stringvalidator.OneOfFuncOptions{})
+
+	expected := "value must be one of the allowed values"
+
+	if diff := cmp.Diff(expected, v.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected description difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(expected, v.MarkdownDescription(context.Background())); diff != "" {
+		t.Errorf("unexpected markdown description difference: %s", diff)
+	}
+
+	if calls != 0 {
+		t.Errorf("expected no source calls, got %d", calls)
+	}
+}
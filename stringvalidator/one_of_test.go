@@ -9,7 +9,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -52,6 +54,20 @@ func TestOneOfValidator(t *testing.T) {
 			},
 			expectError: true,
 		},
+		"large-match": {
+			in:          types.StringValue("value-099"),
+			oneOfValues: testOneOfValues(100),
+		},
+		"large-mismatch": {
+			in:          types.StringValue("value-100"),
+			oneOfValues: testOneOfValues(100),
+			expectError: true,
+		},
+		"large-mismatch-case-insensitive": {
+			in:          types.StringValue("VALUE-001"),
+			oneOfValues: testOneOfValues(100),
+			expectError: true,
+		},
 		"skip-validation-on-null": {
 			in: types.StringNull(),
 			oneOfValues: []string{
@@ -112,14 +128,16 @@ func TestOneOfValidator_Description(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		in       []string
-		expected string
+		in               []string
+		expected         string
+		expectedMarkdown string
 	}
 
 	testCases := map[string]testCase{
 		"quoted-once": {
-			in:       []string{"foo", "bar", "baz"},
-			expected: `value must be one of: ["foo" "bar" "baz"]`,
+			in:               []string{"foo", "bar", "baz"},
+			expected:         `value must be one of: ["foo" "bar" "baz"]`,
+			expectedMarkdown: "value must be one of:\n- `\"foo\"`\n- `\"bar\"`\n- `\"baz\"`",
 		},
 	}
 
@@ -130,11 +148,134 @@ func TestOneOfValidator_Description(t *testing.T) {
 
 			v := stringvalidator.OneOf(test.in...)
 
-			got := v.MarkdownDescription(context.Background())
+			got := v.Description(context.Background())
 
 			if diff := cmp.Diff(got, test.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
+
+			gotMarkdown := v.MarkdownDescription(context.Background())
+
+			if diff := cmp.Diff(gotMarkdown, test.expectedMarkdown); diff != "" {
+				t.Errorf("unexpected Markdown difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOneOfValidator_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		in       types.String
+		expected diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		"suggestion": {
+			in: types.StringValue("us-esat-1"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: ["us-east-1" "us-east-2" "us-west-2"], got: "us-esat-1". Did you mean "us-east-1"?`,
+				),
+			},
+		},
+		"suggestion-case": {
+			in: types.StringValue("US-WEST-2"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: ["us-east-1" "us-east-2" "us-west-2"], got: "US-WEST-2". Did you mean "us-west-2"?`,
+				),
+			},
+		},
+		"no-suggestion": {
+			in: types.StringValue("eu-central-1"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: ["us-east-1" "us-east-2" "us-west-2"], got: "eu-central-1"`,
+				),
+			},
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: test.in,
+			}
+			res := validator.StringResponse{}
+			stringvalidator.OneOf("us-east-1", "us-east-2", "us-west-2").ValidateString(context.TODO(), req, &res)
+
+			if diff := cmp.Diff(res.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOneOfEnum(t *testing.T) {
+	t.Parallel()
+
+	type color string
+
+	const (
+		colorRed  color = "red"
+		colorBlue color = "blue"
+	)
+
+	type testCase struct {
+		in          types.String
+		expectError bool
+	}
+
+	testCases := map[string]testCase{
+		"match": {
+			in: types.StringValue("red"),
+		},
+		"mismatch": {
+			in:          types.StringValue("green"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{
+				ConfigValue: test.in,
+			}
+			res := validator.StringResponse{}
+			stringvalidator.OneOfEnum(colorRed, colorBlue).ValidateString(context.TODO(), req, &res)
+
+			if !res.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if res.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", res.Diagnostics)
+			}
 		})
 	}
 }
+
+// testOneOfValues returns the given number of distinct values, which is
+// large enough to exercise set based lookups.
+func testOneOfValues(count int) []string {
+	values := make([]string, 0, count)
+
+	for i := range count {
+		values = append(values, fmt.Sprintf("value-%03d", i))
+	}
+
+	return values
+}
@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
+)
+
+// PasswordPolicyOptions are the rules of the PasswordPolicy validator. Zero
+// values apply no rule, and at least one rule must be set.
+type PasswordPolicyOptions struct {
+	// MinLength is the minimum number of UTF-8 characters.
+	MinLength int
+
+	// MaxLength is the maximum number of UTF-8 characters.
+	MaxLength int
+
+	// RequireLowercase ensures at least one lowercase letter.
+	RequireLowercase bool
+
+	// RequireUppercase ensures at least one uppercase letter.
+	RequireUppercase bool
+
+	// RequireDigit ensures at least one digit.
+	RequireDigit bool
+
+	// RequireSymbol ensures at least one punctuation or symbol character,
+	// such as "!" or "$".
+	RequireSymbol bool
+
+	// MaxRepeatedCharacters is the maximum number of consecutive identical
+	// characters, such as 2 to allow "aa" but not "aaa".
+	MaxRepeatedCharacters int
+
+	// ForbiddenSubstrings are case-insensitive substrings which must not
+	// appear, such as "password".
+	ForbiddenSubstrings []string
+
+	// NotContaining are the expressions of string attributes whose values
+	// must not appear, compared case-insensitively, such as the username.
+	// Relative expressions are resolved using the attribute being validated.
+	// Null, unknown, and empty values are skipped. This rule is not applied
+	// to function parameters.
+	NotContaining path.Expressions
+}
+
+var _ validator.String = passwordPolicyValidator{}
+var _ function.StringParameterValidator = passwordPolicyValidator{}
+var _ validatorspec.ValidatorWithConstraint = passwordPolicyValidator{}
+
+type passwordPolicyValidator struct {
+	opts PasswordPolicyOptions
+}
+
+// invalidUsageMessage returns an error message if the options are invalid.
+func (v passwordPolicyValidator) invalidUsageMessage() string {
+	var errs []error
+
+	if v.opts.MinLength < 0 || v.opts.MaxLength < 0 || v.opts.MaxRepeatedCharacters < 0 {
+		errs = append(errs, errors.New("MinLength, MaxLength, and MaxRepeatedCharacters cannot be negative"))
+	}
+
+	if v.opts.MaxLength > 0 && v.opts.MinLength > v.opts.MaxLength {
+		errs = append(errs, fmt.Errorf("MinLength cannot be greater than MaxLength - MinLength: %d, MaxLength: %d", v.opts.MinLength, v.opts.MaxLength))
+	}
+
+	for _, substring := range v.opts.ForbiddenSubstrings {
+		if substring == "" {
+			errs = append(errs, errors.New("ForbiddenSubstrings cannot contain an empty string"))
+
+			break
+		}
+	}
+
+	if len(v.rules()) == 0 {
+		errs = append(errs, errors.New("at least one rule must be set"))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err.Error()
+	}
+
+	return ""
+}
+
+// rules returns the plain text description of each enabled rule.
+func (v passwordPolicyValidator) rules() []string {
+	var rules []string
+
+	if v.opts.MinLength > 0 {
+		rules = append(rules, v.minLengthRule())
+	}
+
+	if v.opts.MaxLength > 0 {
+		rules = append(rules, v.maxLengthRule())
+	}
+
+	if v.opts.RequireLowercase {
+		rules = append(rules, passwordPolicyLowercaseRule)
+	}
+
+	if v.opts.RequireUppercase {
+		rules = append(rules, passwordPolicyUppercaseRule)
+	}
+
+	if v.opts.RequireDigit {
+		rules = append(rules, passwordPolicyDigitRule)
+	}
+
+	if v.opts.RequireSymbol {
+		rules = append(rules, passwordPolicySymbolRule)
+	}
+
+	if v.opts.MaxRepeatedCharacters > 0 {
+		rules = append(rules, v.maxRepeatedCharactersRule())
+	}
+
+	for _, substring := range v.opts.ForbiddenSubstrings {
+		rules = append(rules, forbiddenSubstringRule(substring))
+	}
+
+	for _, expression := range v.opts.NotContaining {
+		rules = append(rules, fmt.Sprintf("not containing the value of %s", expression))
+	}
+
+	return rules
+}
+
+const (
+	passwordPolicyLowercaseRule = "at least one lowercase letter"
+	passwordPolicyUppercaseRule = "at least one uppercase letter"
+	passwordPolicyDigitRule     = "at least one digit"
+	passwordPolicySymbolRule    = "at least one symbol"
+)
+
+func (v passwordPolicyValidator) minLengthRule() string {
+	return fmt.Sprintf("at least %d characters", v.opts.MinLength)
+}
+
+func (v passwordPolicyValidator) maxLengthRule() string {
+	return fmt.Sprintf("at most %d characters", v.opts.MaxLength)
+}
+
+func (v passwordPolicyValidator) maxRepeatedCharactersRule() string {
+	return fmt.Sprintf("at most %d consecutive identical characters", v.opts.MaxRepeatedCharacters)
+}
+
+func forbiddenSubstringRule(substring string) string {
+	return fmt.Sprintf("not containing %q", substring)
+}
+
+func (v passwordPolicyValidator) Description(_ context.Context) string {
+	rules := v.rules()
+
+	// A policy without rules is invalid, but is still described, such as in
+	// documentation.
+	if len(rules) == 0 {
+		return "value must satisfy the password policy, which has no rules"
+	}
+
+	return fmt.Sprintf("value must satisfy the password policy: %s", strings.Join(rules, "; "))
+}
+
+func (v passwordPolicyValidator) MarkdownDescription(ctx context.Context) string {
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v passwordPolicyValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	constraint := validatorspec.Constraint{
+		Kind:   validatorspec.KindPasswordPolicy,
+		Values: validatorspec.StringValues(v.opts.ForbiddenSubstrings...),
+		Paths:  v.opts.NotContaining,
+	}
+
+	if v.opts.MinLength > 0 {
+		constraint.Min = new(big.Float).SetInt64(int64(v.opts.MinLength))
+	}
+
+	if v.opts.MaxLength > 0 {
+		constraint.Max = new(big.Float).SetInt64(int64(v.opts.MaxLength))
+	}
+
+	return constraint
+}
+
+// validate returns the description of each unmet rule, excluding
+// NotContaining.
+func (v passwordPolicyValidator) validate(value string) []string {
+	var unmet []string
+
+	length := utf8.RuneCountInString(value)
+
+	if v.opts.MinLength > 0 && length < v.opts.MinLength {
+		unmet = append(unmet, v.minLengthRule())
+	}
+
+	if v.opts.MaxLength > 0 && length > v.opts.MaxLength {
+		unmet = append(unmet, v.maxLengthRule())
+	}
+
+	var hasLowercase, hasUppercase, hasDigit, hasSymbol bool
+	var previous rune
+	var repeated, maxRepeated int
+
+	for _, r := range value {
+		hasLowercase = hasLowercase || unicode.IsLower(r)
+		hasUppercase = hasUppercase || unicode.IsUpper(r)
+		hasDigit = hasDigit || unicode.IsDigit(r)
+		hasSymbol = hasSymbol || unicode.IsPunct(r) || unicode.IsSymbol(r)
+
+		if repeated > 0 && r == previous {
+			repeated++
+		} else {
+			repeated = 1
+		}
+
+		previous = r
+		maxRepeated = max(maxRepeated, repeated)
+	}
+
+	if v.opts.RequireLowercase && !hasLowercase {
+		unmet = append(unmet, passwordPolicyLowercaseRule)
+	}
+
+	if v.opts.RequireUppercase && !hasUppercase {
+		unmet = append(unmet, passwordPolicyUppercaseRule)
+	}
+
+	if v.opts.RequireDigit && !hasDigit {
+		unmet = append(unmet, passwordPolicyDigitRule)
+	}
+
+	if v.opts.RequireSymbol && !hasSymbol {
+		unmet = append(unmet, passwordPolicySymbolRule)
+	}
+
+	if v.opts.MaxRepeatedCharacters > 0 && maxRepeated > v.opts.MaxRepeatedCharacters {
+		unmet = append(unmet, v.maxRepeatedCharactersRule())
+	}
+
+	for _, substring := range v.opts.ForbiddenSubstrings {
+		if containsFold(value, substring) {
+			unmet = append(unmet, forbiddenSubstringRule(substring))
+		}
+	}
+
+	return unmet
+}
+
+// validateNotContaining returns the description of each unmet NotContaining
+// rule.
+func (v passwordPolicyValidator) validateNotContaining(ctx context.Context, req validator.StringRequest, value string) ([]string, diag.Diagnostics) {
+	var unmet []string
+	var diags diag.Diagnostics
+
+	for _, expression := range v.opts.NotContaining {
+		matchedPaths, matchedPathsDiags := req.Config.PathMatches(ctx, req.PathExpression.Merge(expression))
+
+		diags.Append(matchedPathsDiags...)
+
+		// Collect all errors
+		if matchedPathsDiags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			// Skip the attribute this validator is applied to
+			if matchedPath.Equal(req.Path) {
+				continue
+			}
+
+			other, otherDiags := passwordPolicyStringValue(ctx, req.Config, matchedPath)
+
+			diags.Append(otherDiags...)
+
+			if other != "" && containsFold(value, other) {
+				unmet = append(unmet, fmt.Sprintf("not containing the value of %s", expression))
+
+				break
+			}
+		}
+	}
+
+	return unmet, diags
+}
+
+// passwordPolicyStringValue returns the known string value at the given path
+// or an empty string if it is null, unknown, or not a string.
+func passwordPolicyStringValue(ctx context.Context, config tfsdk.Config, p path.Path) (string, diag.Diagnostics) {
+	var value attr.Value
+
+	diags := config.GetAttribute(ctx, p, &value)
+
+	if diags.HasError() || value == nil || value.IsNull() || value.IsUnknown() {
+		return "", diags
+	}
+
+	stringValuable, ok := value.(basetypes.StringValuable)
+
+	if !ok {
+		return "", diags
+	}
+
+	stringValue, stringValueDiags := stringValuable.ToStringValue(ctx)
+
+	diags.Append(stringValueDiags...)
+
+	if stringValueDiags.HasError() || stringValue.IsNull() || stringValue.IsUnknown() {
+		return "", diags
+	}
+
+	return stringValue.ValueString(), diags
+}
+
+// passwordPolicyFailureDescription describes the unmet rules. The value is
+// never included.
+func passwordPolicyFailureDescription(unmet []string) string {
+	return fmt.Sprintf("value must satisfy the password policy, unmet rules: %s", strings.Join(unmet, "; "))
+}
+
+func (v passwordPolicyValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if msg := v.invalidUsageMessage(); msg != "" {
+		response.Diagnostics.Append(validatordiag.InvalidValidatorUsageDiagnostic(request.Path, "PasswordPolicy", msg))
+
+		return
+	}
+
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	unmet := v.validate(value)
+
+	notContainingUnmet, diags := v.validateNotContaining(ctx, request, value)
+
+	response.Diagnostics.Append(diags...)
+
+	unmet = append(unmet, notContainingUnmet...)
+
+	if len(unmet) == 0 {
+		return
+	}
+
+	response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		request.Path,
+		passwordPolicyFailureDescription(unmet),
+		validatordiag.RedactedValue,
+	))
+}
+
+func (v passwordPolicyValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	// Return an error if the validator has been created in an invalid state
+	if msg := v.invalidUsageMessage(); msg != "" {
+		response.Error = validatorfuncerr.InvalidValidatorUsageFuncError(request.ArgumentPosition, "PasswordPolicy", msg)
+
+		return
+	}
+
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	unmet := v.validate(request.Value.ValueString())
+
+	if len(unmet) == 0 {
+		return
+	}
+
+	response.Error = validatorfuncerr.InvalidParameterValueFuncError(
+		request.ArgumentPosition,
+		passwordPolicyFailureDescription(unmet),
+		validatordiag.RedactedValue,
+	)
+}
+
+// PasswordPolicy returns a validator which ensures that any configured
+// attribute or function parameter value satisfies every rule of the given
+// password policy. Null (unconfigured) and unknown (known after apply) values
+// are skipped.
+//
+// A single diagnostic lists every unmet rule. Diagnostics never include the
+// configured value or the values of NotContaining attributes, so this
+// validator is suitable for write-only and sensitive attributes without the
+// Sensitive wrapper.
+//
+// Options without any rule, negative lengths, a MinLength greater than
+// MaxLength, or an empty ForbiddenSubstrings value will result in an
+// implementation error message during validation.
+func PasswordPolicy(opts PasswordPolicyOptions) passwordPolicyValidator {
+	return passwordPolicyValidator{
+		opts: opts,
+	}
+}
+
+// containsFold reports whether substr is within s, ignoring case.
+func containsFold(s, substr string) bool {
+	return strings.Contains(foldCase(s), foldCase(substr))
+}
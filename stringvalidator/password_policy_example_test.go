@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func ExamplePasswordPolicy() {
+	// Used within a Schema method of a Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Required: true,
+			},
+			"password_wo": schema.StringAttribute{
+				Required:  true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					// Validate string value must be 12 to 64 characters with
+					// mixed case letters and a digit, and must not contain
+					// the username.
+					stringvalidator.PasswordPolicy(stringvalidator.PasswordPolicyOptions{
+						MinLength:        12,
+						MaxLength:        64,
+						RequireLowercase: true,
+						RequireUppercase: true,
+						RequireDigit:     true,
+						NotContaining:    path.Expressions{path.MatchRoot("username")},
+					}),
+				},
+			},
+		},
+	}
+}
+
+func ExamplePasswordPolicy_function() {
+	_ = function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "example_param",
+				Validators: []function.StringParameterValidator{
+					// Validate string value must be at least 12 characters
+					// and must not contain "password".
+					stringvalidator.PasswordPolicy(stringvalidator.PasswordPolicyOptions{
+						MinLength:           12,
+						ForbiddenSubstrings: []string{"password"},
+					}),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+// testPasswordPolicyConfig returns a configuration with the given username
+// and password values.
+func testPasswordPolicyConfig(username tftypes.Value, password string) tfsdk.Config {
+	return tfsdk.Config{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"username": schema.StringAttribute{},
+				"password": schema.StringAttribute{},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"username": tftypes.String,
+				"password": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"username": username,
+			"password": tftypes.NewValue(tftypes.String, password),
+		}),
+	}
+}
+
+func TestPasswordPolicyValidator(t *testing.T) {
+	t.Parallel()
+
+	strict := stringvalidator.PasswordPolicyOptions{
+		MinLength:             12,
+		MaxLength:             64,
+		RequireLowercase:      true,
+		RequireUppercase:      true,
+		RequireDigit:          true,
+		RequireSymbol:         true,
+		MaxRepeatedCharacters: 2,
+		ForbiddenSubstrings:   []string{"password"},
+		NotContaining:         path.Expressions{path.MatchRoot("username")},
+	}
+
+	type testCase struct {
+		val         types.String
+		username    tftypes.Value
+		opts        stringvalidator.PasswordPolicyOptions
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val:  types.StringUnknown(),
+			opts: strict,
+		},
+		"null": {
+			val:  types.StringNull(),
+			opts: strict,
+		},
+		"valid": {
+			val:  types.StringValue("c0rrect-Horse-battery"),
+			opts: strict,
+		},
+		"too-short": {
+			val:         types.StringValue("c0rrect-Hor"),
+			opts:        strict,
+			expectError: true,
+		},
+		"too-long": {
+			val:         types.StringValue("c0rrect-Horse"),
+			opts:        stringvalidator.PasswordPolicyOptions{MaxLength: 12},
+			expectError: true,
+		},
+		"multibyte-length": {
+			val:  types.StringValue("пароль"),
+			opts: stringvalidator.PasswordPolicyOptions{MaxLength: 6},
+		},
+		"missing-lowercase": {
+			val:         types.StringValue("C0RRECT-HORSE"),
+			opts:        stringvalidator.PasswordPolicyOptions{RequireLowercase: true},
+			expectError: true,
+		},
+		"missing-uppercase": {
+			val:         types.StringValue("c0rrect-horse"),
+			opts:        stringvalidator.PasswordPolicyOptions{RequireUppercase: true},
+			expectError: true,
+		},
+		"missing-digit": {
+			val:         types.StringValue("correct-Horse"),
+			opts:        stringvalidator.PasswordPolicyOptions{RequireDigit: true},
+			expectError: true,
+		},
+		"missing-symbol": {
+			val:         types.StringValue("c0rrectHorse"),
+			opts:        stringvalidator.PasswordPolicyOptions{RequireSymbol: true},
+			expectError: true,
+		},
+		"repeated-characters": {
+			val:         types.StringValue("c0rrect-Horseee"),
+			opts:        stringvalidator.PasswordPolicyOptions{MaxRepeatedCharacters: 2},
+			expectError: true,
+		},
+		"repeated-characters-at-limit": {
+			val:  types.StringValue("c0rrect-Horsee"),
+			opts: stringvalidator.PasswordPolicyOptions{MaxRepeatedCharacters: 2},
+		},
+		"forbidden-substring": {
+			val:         types.StringValue("My-PassWord-1"),
+			opts:        stringvalidator.PasswordPolicyOptions{ForbiddenSubstrings: []string{"password"}},
+			expectError: true,
+		},
+		"contains-username": {
+			val:         types.StringValue("c0rrect-Horse-ADMIN"),
+			username:    tftypes.NewValue(tftypes.String, "admin"),
+			opts:        strict,
+			expectError: true,
+		},
+		"username-null": {
+			val:      types.StringValue("c0rrect-Horse-battery"),
+			username: tftypes.NewValue(tftypes.String, nil),
+			opts:     strict,
+		},
+		"username-unknown": {
+			val:      types.StringValue("c0rrect-Horse-battery"),
+			username: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			opts:     strict,
+		},
+		"username-empty": {
+			val:      types.StringValue("c0rrect-Horse-battery"),
+			username: tftypes.NewValue(tftypes.String, ""),
+			opts:     strict,
+		},
+		"invalid-usage-min-greater-than-max": {
+			val:         types.StringValue("c0rrect-Horse-battery"),
+			opts:        stringvalidator.PasswordPolicyOptions{MinLength: 12, MaxLength: 8},
+			expectError: true,
+		},
+		"invalid-usage-negative": {
+			val:         types.StringValue("c0rrect-Horse-battery"),
+			opts:        stringvalidator.PasswordPolicyOptions{MaxRepeatedCharacters: -1},
+			expectError: true,
+		},
+		"invalid-usage-empty-forbidden-substring": {
+			val:         types.StringValue("c0rrect-Horse-battery"),
+			opts:        stringvalidator.PasswordPolicyOptions{ForbiddenSubstrings: []string{""}},
+			expectError: true,
+		},
+		"invalid-usage-no-rules": {
+			val:         types.StringValue("c0rrect-Horse-battery"),
+			opts:        stringvalidator.PasswordPolicyOptions{},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run("ValidateString - "+name, func(t *testing.T) {
+			t.Parallel()
+
+			username := test.username
+
+			if username.Type() == nil {
+				username = tftypes.NewValue(tftypes.String, "someone")
+			}
+
+			request := validator.StringRequest{
+				Path:           path.Root("password"),
+				PathExpression: path.MatchRoot("password"),
+				ConfigValue:    test.val,
+				Config:         testPasswordPolicyConfig(username, test.val.ValueString()),
+			}
+			response := validator.StringResponse{}
+			stringvalidator.PasswordPolicy(test.opts).ValidateString(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+
+		// Function parameters do not apply NotContaining.
+		if test.username.Type() != nil {
+			continue
+		}
+
+		t.Run("ValidateParameterString - "+name, func(t *testing.T) {
+			t.Parallel()
+
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			stringvalidator.PasswordPolicy(test.opts).ValidateParameterString(context.Background(), request, &response)
+
+			if response.Error == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Error != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Error)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicyValidator_Diagnostics(t *testing.T) {
+	t.Parallel()
+
+	opts := stringvalidator.PasswordPolicyOptions{
+		MinLength:           12,
+		RequireDigit:        true,
+		RequireSymbol:       true,
+		ForbiddenSubstrings: []string{"secret"},
+		NotContaining:       path.Expressions{path.MatchRoot("username")},
+	}
+
+	request := validator.StringRequest{
+		Path:           path.Root("password"),
+		PathExpression: path.MatchRoot("password"),
+		ConfigValue:    types.StringValue("AdminSecret"),
+		Config:         testPasswordPolicyConfig(tftypes.NewValue(tftypes.String, "admin"), "AdminSecret"),
+	}
+	response := validator.StringResponse{}
+	stringvalidator.PasswordPolicy(opts).ValidateString(context.Background(), request, &response)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("password"),
+			"Invalid Attribute Value",
+			`Attribute password value must satisfy the password policy, unmet rules: `+
+				`at least 12 characters; at least one digit; at least one symbol; `+
+				`not containing "secret"; not containing the value of username, got: (sensitive value)`,
+		),
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestPasswordPolicyValidator_Description(t *testing.T) {
+	t.Parallel()
+
+	opts := stringvalidator.PasswordPolicyOptions{
+		MinLength:             12,
+		RequireUppercase:      true,
+		MaxRepeatedCharacters: 2,
+	}
+
+	got := stringvalidator.PasswordPolicy(opts).Description(context.Background())
+	expected := "value must satisfy the password policy: at least 12 characters; at least one uppercase letter; at most 2 consecutive identical characters"
+
+	if got != expected {
+		t.Errorf("expected description %q, got: %q", expected, got)
+	}
+}
+
+func TestPasswordPolicyValidator_DescriptionNoRules(t *testing.T) {
+	t.Parallel()
+
+	got := stringvalidator.PasswordPolicy(stringvalidator.PasswordPolicyOptions{}).Description(context.Background())
+	expected := "value must satisfy the password policy, which has no rules"
+
+	if got != expected {
+		t.Errorf("expected description %q, got: %q", expected, got)
+	}
+}
@@ -10,12 +10,15 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var _ validator.String = regexMatchesValidator{}
 var _ function.StringParameterValidator = regexMatchesValidator{}
+var _ validatorspec.ValidatorWithConstraint = regexMatchesValidator{}
 
 type regexMatchesValidator struct {
 	regexp  *regexp.Regexp
@@ -30,7 +33,19 @@ func (validator regexMatchesValidator) Description(_ context.Context) string {
 }
 
 func (validator regexMatchesValidator) MarkdownDescription(ctx context.Context) string {
-	return validator.Description(ctx)
+	return validatormarkdown.Describe(ctx, validator)
+}
+
+func (validator regexMatchesValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	constraint := validatorspec.Constraint{
+		Kind: validatorspec.KindRegexMatches,
+	}
+
+	if validator.regexp != nil {
+		constraint.Pattern = validator.regexp.String()
+	}
+
+	return constraint
 }
 
 func (v regexMatchesValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/internal/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestSensitiveValidatorValidateString(t *testing.T) {
+	t.Parallel()
+
+	value := types.StringValue("ab")
+
+	type testCase struct {
+		validator validator.String
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"error": {
+			validator: testvalidator.ErrorString("Error Summary", "value must be valid, got: "+value.String()),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"value must be valid, got: (sensitive value)",
+				),
+			},
+		},
+		"short-value": {
+			validator: testvalidator.ErrorString("Error Summary", "value ab is not allowed, as abc and "+value.String()+" are reserved"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"value (sensitive value) is not allowed, as abc and (sensitive value) are reserved",
+				),
+			},
+		},
+		"no-value": {
+			validator: testvalidator.ErrorString("Error Summary", "error detail"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Error Summary",
+					"error detail",
+				),
+			},
+		},
+		"no-diagnostics": {
+			validator: stringvalidator.All(),
+			expected:  nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    value,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Sensitive(test.validator).ValidateString(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSensitiveValidatorValidateParameterString(t *testing.T) {
+	t.Parallel()
+
+	value := types.StringValue("ab")
+
+	request := function.StringParameterValidatorRequest{
+		ArgumentPosition: 0,
+		Value:            value,
+	}
+	response := function.StringParameterValidatorResponse{}
+	stringvalidator.Sensitive(
+		testvalidator.ErrorString("Error Summary", "value must be valid, got: "+value.String()),
+	).ValidateParameterString(context.Background(), request, &response)
+
+	expected := function.NewArgumentFuncError(0, "Error Summary: value must be valid, got: (sensitive value)")
+
+	if diff := cmp.Diff(response.Error, expected); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+}
+
+func TestSensitiveValidatorValidateStringEchoedValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator validator.String
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"one-of-suggestion": {
+			validator: stringvalidator.OneOf("hunter1"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: ["hunter1"], got: (sensitive value)`,
+				),
+			},
+		},
+		"deprecated-value": {
+			validator: stringvalidator.DeprecatedValues(map[string]string{
+				"hunter2": "rotate the password",
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Attribute Value",
+					"Attribute test value (sensitive value) is deprecated: rotate the password",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue("hunter2"),
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Sensitive(test.validator).ValidateString(context.Background(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
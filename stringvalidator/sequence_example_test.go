@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ExampleSequence() {
+	// Used within a Schema method of a DataSource, Provider, or Resource
+	_ = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"example_attr": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					// Validate this String value is at most 4096 bytes
+					// before validating it against the JSON schema, which
+					// is skipped for longer values.
+					stringvalidator.Sequence(
+						stringvalidator.LengthAtMost(4096),
+						stringvalidator.JSONMatchesSchema(`{"type": "object"}`),
+					),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// closestValue returns the candidate with the smallest case-insensitive edit
+// distance to the value, if that distance is small enough for the candidate to
+// be a plausible correction. Ties are broken by candidate order.
+func closestValue(value string, candidates []string) (string, bool) {
+	value = strings.ToLower(value)
+	valueLength := utf8.RuneCountInString(value)
+
+	// Allow roughly one edit for every three characters.
+	maxDistance := max(1, valueLength/3)
+
+	closest := ""
+	closestDistance := maxDistance + 1
+
+	for _, candidate := range candidates {
+		lowerCandidate := strings.ToLower(candidate)
+
+		// The edit distance is at least the difference in length, which
+		// skips the comparison for most candidates of large value lists.
+		if lengthDifference := utf8.RuneCountInString(lowerCandidate) - valueLength; lengthDifference >= closestDistance || -lengthDifference >= closestDistance {
+			continue
+		}
+
+		if distance := editDistance(value, lowerCandidate); distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+
+	return closest, closestDistance <= maxDistance
+}
+
+// editDistance returns the Levenshtein distance between a and b, which is the
+// number of single rune insertions, deletions, and substitutions required to
+// change a into b.
+func editDistance(a, b string) int {
+	runesA, runesB := []rune(a), []rune(b)
+
+	previous := make([]int, len(runesB)+1)
+	current := make([]int, len(runesB)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i, runeA := range runesA {
+		current[0] = i + 1
+
+		for j, runeB := range runesB {
+			substitution := previous[j]
+
+			if runeA != runeB {
+				substitution++
+			}
+
+			current[j+1] = min(previous[j+1]+1, current[j]+1, substitution)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(runesB)]
+}
+
+// suggestionValue returns the value description used in diagnostics,
+// followed by a suggested correction from the candidates, if any.
+func suggestionValue(value string, candidates []string) string {
+	got := fmt.Sprintf("%q", value)
+
+	if suggestion, ok := closestValue(value, candidates); ok {
+		got += fmt.Sprintf(". Did you mean %q?", suggestion)
+	}
+
+	return got
+}
@@ -6,6 +6,7 @@ package stringvalidator
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -13,10 +14,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.String = utf8LengthAtMostValidator{}
 var _ function.StringParameterValidator = utf8LengthAtMostValidator{}
+var _ validatorspec.ValidatorWithConstraint = utf8LengthAtMostValidator{}
 
 type utf8LengthAtMostValidator struct {
 	maxLength int
@@ -31,7 +35,14 @@ func (validator utf8LengthAtMostValidator) Description(_ context.Context) string
 }
 
 func (validator utf8LengthAtMostValidator) MarkdownDescription(ctx context.Context) string {
-	return validator.Description(ctx)
+	return validatormarkdown.Describe(ctx, validator)
+}
+
+func (validator utf8LengthAtMostValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindUTF8Length,
+		Max:  new(big.Float).SetInt64(int64(validator.maxLength)),
+	}
 }
 
 func (v utf8LengthAtMostValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
@@ -6,6 +6,7 @@ package stringvalidator
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -13,10 +14,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatormarkdown"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorspec"
 )
 
 var _ validator.String = utf8LengthBetweenValidator{}
 var _ function.StringParameterValidator = utf8LengthBetweenValidator{}
+var _ validatorspec.ValidatorWithConstraint = utf8LengthBetweenValidator{}
 
 type utf8LengthBetweenValidator struct {
 	maxLength int
@@ -32,7 +36,15 @@ func (v utf8LengthBetweenValidator) Description(_ context.Context) string {
 }
 
 func (v utf8LengthBetweenValidator) MarkdownDescription(ctx context.Context) string {
-	return v.Description(ctx)
+	return validatormarkdown.Describe(ctx, v)
+}
+
+func (v utf8LengthBetweenValidator) Constraint(_ context.Context) validatorspec.Constraint {
+	return validatorspec.Constraint{
+		Kind: validatorspec.KindUTF8Length,
+		Min:  new(big.Float).SetInt64(int64(v.minLength)),
+		Max:  new(big.Float).SetInt64(int64(v.maxLength)),
+	}
 }
 
 func (v utf8LengthBetweenValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2022, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+)
+
+// validationFailure is a single reason a value did not pass validation along
+// with a representation of the part of the value which caused it. Validators
+// which can report multiple reasons, or which must not echo the configured
+// value, return these so schema and function parameter validation produce
+// consistent messages.
+type validationFailure struct {
+	description string
+	value       string
+}
+
+// validationFailureDiagnostics converts failures into attribute diagnostics.
+func validationFailureDiagnostics(p path.Path, failures []validationFailure) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, failure := range failures {
+		diags.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			p,
+			failure.description,
+			failure.value,
+		))
+	}
+
+	return diags
+}
+
+// validationFailureFuncError converts failures into a function error.
+func validationFailureFuncError(argumentPosition int64, failures []validationFailure) *function.FuncError {
+	var funcErr *function.FuncError
+
+	for _, failure := range failures {
+		funcErr = function.ConcatFuncErrors(
+			funcErr,
+			validatorfuncerr.InvalidParameterValueFuncError(
+				argumentPosition,
+				failure.description,
+				failure.value,
+			),
+		)
+	}
+
+	return funcErr
+}